@@ -3,6 +3,8 @@ package gtl
 import (
 	"bytes"
 	"io"
+	"unicode"
+	"unicode/utf8"
 	"unsafe"
 )
 
@@ -100,6 +102,186 @@ func (b Bytes) Contains(c byte) bool {
 	return bytes.IndexByte(b, c) != -1
 }
 
+// IndexBytes returns the index of the first occurrence of `sub`, or -1 if `sub` is not present.
+func (b Bytes) IndexBytes(sub []byte) int {
+	return bytes.Index(b, sub)
+}
+
+// IndexRune returns the index of the first occurrence of the UTF-8 encoded rune `r`, or -1.
+func (b Bytes) IndexRune(r rune) int {
+	return bytes.IndexRune(b, r)
+}
+
+// IndexAny returns the index of the first occurrence of any of the UTF-8 encoded runes in `chars`, or -1.
+func (b Bytes) IndexAny(chars string) int {
+	return bytes.IndexAny(b, chars)
+}
+
+// LastIndex returns the index of the last occurrence of `sub`, or -1 if `sub` is not present.
+func (b Bytes) LastIndex(sub []byte) int {
+	return bytes.LastIndex(b, sub)
+}
+
+// LastIndexByte returns the index of the last occurrence of `c`, or -1 if `c` is not present.
+func (b Bytes) LastIndexByte(c byte) int {
+	return bytes.LastIndexByte(b, c)
+}
+
+// ContainsBytes returns whether `b` contains `sub` or not.
+func (b Bytes) ContainsBytes(sub []byte) bool {
+	return bytes.Contains(b, sub)
+}
+
+// HasPrefix returns whether `b` begins with `prefix`.
+func (b Bytes) HasPrefix(prefix []byte) bool {
+	return bytes.HasPrefix(b, prefix)
+}
+
+// HasSuffix returns whether `b` ends with `suffix`.
+func (b Bytes) HasSuffix(suffix []byte) bool {
+	return bytes.HasSuffix(b, suffix)
+}
+
+// Equal returns whether `b` and `b2` hold the same bytes.
+func (b Bytes) Equal(b2 []byte) bool {
+	return bytes.Equal(b, b2)
+}
+
+// Cut slices `b` around the first occurrence of `sep`, returning the bytes before and after `sep`.
+//
+// If `sep` is not present, Cut returns `b`, nil and false.
+func (b Bytes) Cut(sep []byte) (before, after Bytes, found bool) {
+	if i := bytes.Index(b, sep); i >= 0 {
+		return b[:i], b[i+len(sep):], true
+	}
+
+	return b, nil, false
+}
+
+// Split returns an Iterator over the sub-slices of `b` separated by `sep`.
+//
+// If `sep` is empty, Split splits after each UTF-8 sequence, so an empty `b` yields no sub-slices.
+// The sub-slices share the storage with `b`.
+func (b Bytes) Split(sep []byte) Iterator[Bytes] {
+	done := len(sep) == 0 && len(b) == 0
+
+	return iterFrom(func() *Bytes {
+		if done {
+			return nil
+		}
+
+		var part Bytes
+
+		if len(sep) == 0 {
+			_, size := utf8.DecodeRune(b)
+			part, b = b[:size:size], b[size:]
+			done = len(b) == 0
+		} else {
+			before, after, found := b.Cut(sep)
+			part, b = before[:len(before):len(before)], after
+			done = !found
+		}
+
+		return &part
+	})
+}
+
+// Fields returns an Iterator over the sub-slices of `b` separated by one or more white spaces,
+// as defined by unicode.IsSpace.
+//
+// The sub-slices share the storage with `b`.
+func (b Bytes) Fields() Iterator[Bytes] {
+	return iterFrom(func() *Bytes {
+		start := bytes.IndexFunc(b, func(r rune) bool {
+			return !unicode.IsSpace(r)
+		})
+		if start == -1 {
+			return nil
+		}
+
+		end := bytes.IndexFunc(b[start:], unicode.IsSpace)
+		if end == -1 {
+			end = len(b)
+		} else {
+			end += start
+		}
+
+		field := b[start:end:end]
+		b = b[end:]
+
+		return &field
+	})
+}
+
+// TrimSpace removes the leading and trailing white spaces, as defined by unicode.IsSpace.
+func (b *Bytes) TrimSpace() {
+	*b = bytes.TrimSpace(*b)
+}
+
+// ToLower converts all the Unicode letters to their lower case.
+//
+// The conversion is done in place if the length of the encoded letters doesn't change.
+func (b *Bytes) ToLower() {
+	b.mapRunes(unicode.ToLower)
+}
+
+// ToUpper converts all the Unicode letters to their upper case.
+//
+// The conversion is done in place if the length of the encoded letters doesn't change.
+func (b *Bytes) ToUpper() {
+	b.mapRunes(unicode.ToUpper)
+}
+
+func (b *Bytes) mapRunes(fn func(rune) rune) {
+	bts := *b
+
+	for i := 0; i < len(bts); {
+		c := bts[i]
+		if c < utf8.RuneSelf {
+			bts[i] = byte(fn(rune(c)))
+			i++
+
+			continue
+		}
+
+		r, size := utf8.DecodeRune(bts[i:])
+
+		mapped := fn(r)
+		if utf8.RuneLen(mapped) != size {
+			// the encoded length changes, so the rest can't be converted in place
+			*b = append(bts[:i], bytes.Map(fn, bts[i:])...)
+			return
+		}
+
+		utf8.EncodeRune(bts[i:], mapped)
+		i += size
+	}
+}
+
+// ReplaceAll replaces all the non-overlapping occurrences of `old` with `new`.
+//
+// If `old` and `new` have the same length, the replacement is done in place.
+func (b *Bytes) ReplaceAll(old, new []byte) {
+	if len(old) == 0 {
+		*b = bytes.ReplaceAll(*b, old, new)
+		return
+	}
+
+	if len(old) == len(new) {
+		for i := 0; ; {
+			j := bytes.Index((*b)[i:], old)
+			if j == -1 {
+				return
+			}
+
+			i += j
+			i += copy((*b)[i:], new)
+		}
+	}
+
+	*b = bytes.ReplaceAll(*b, old, new)
+}
+
 // Resize increases (if needed) the length of Bytes to match `n`.
 func (b *Bytes) Resize(n int) {
 	vc := (*Vec[byte])(b)
@@ -123,3 +305,15 @@ func (b *Bytes) Push(bts ...byte) {
 	vc := (*Vec[byte])(b)
 	vc.Push(bts...)
 }
+
+// Iter returns an iterator over Bytes.
+func (b *Bytes) Iter() RandomAccessIterator[byte] {
+	vc := (*Vec[byte])(b)
+	return vc.iter()
+}
+
+// ReverseIter returns an iterator that traverses Bytes backward.
+func (b *Bytes) ReverseIter() RandomAccessIterator[byte] {
+	vc := (*Vec[byte])(b)
+	return vc.ReverseIter()
+}
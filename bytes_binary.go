@@ -0,0 +1,164 @@
+package gtl
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+// ErrVarintOverflow is returned when decoding a varint that doesn't fit in 64 bits.
+var ErrVarintOverflow = errors.New("gtl: varint overflows a 64-bit integer")
+
+// AppendUvarint appends `v` encoded as an unsigned varint.
+func (b *Bytes) AppendUvarint(v uint64) {
+	*b = binary.AppendUvarint(*b, v)
+}
+
+// AppendVarint appends `v` encoded as a signed (zig-zag) varint.
+func (b *Bytes) AppendVarint(v int64) {
+	*b = binary.AppendVarint(*b, v)
+}
+
+// AppendUint16 appends `v` encoded in 2 bytes using `order`.
+func (b *Bytes) AppendUint16(order ByteOrder, v uint16) {
+	*b = order.AppendUint16(*b, v)
+}
+
+// AppendUint32 appends `v` encoded in 4 bytes using `order`.
+func (b *Bytes) AppendUint32(order ByteOrder, v uint32) {
+	*b = order.AppendUint32(*b, v)
+}
+
+// AppendUint64 appends `v` encoded in 8 bytes using `order`.
+func (b *Bytes) AppendUint64(order ByteOrder, v uint64) {
+	*b = order.AppendUint64(*b, v)
+}
+
+// AppendFloat32 appends the IEEE 754 representation of `v` using `order`.
+func (b *Bytes) AppendFloat32(order ByteOrder, v float32) {
+	b.AppendUint32(order, math.Float32bits(v))
+}
+
+// AppendFloat64 appends the IEEE 754 representation of `v` using `order`.
+func (b *Bytes) AppendFloat64(order ByteOrder, v float64) {
+	b.AppendUint64(order, math.Float64bits(v))
+}
+
+// AppendPrefixedBytes appends `bts` preceded by its length encoded as an unsigned varint.
+func (b *Bytes) AppendPrefixedBytes(bts []byte) {
+	b.AppendUvarint(uint64(len(bts)))
+	b.Append(bts...)
+}
+
+// AppendPrefixedString appends `s` preceded by its length encoded as an unsigned varint.
+func (b *Bytes) AppendPrefixedString(s string) {
+	b.AppendUvarint(uint64(len(s)))
+	*b = append(*b, s...)
+}
+
+// readFixed returns the next `n` unread bytes moving the cursor.
+//
+// If there are less than `n` unread bytes, the cursor is not moved and io.ErrUnexpectedEOF is returned.
+func (bb *BytesBuffer) readFixed(n int) (Bytes, error) {
+	if bb.Len() < n {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return bb.Next(n), nil
+}
+
+// ReadUvarint decodes an unsigned varint.
+//
+// If the input is truncated, the cursor is not moved and the Result holds io.ErrUnexpectedEOF.
+func (bb *BytesBuffer) ReadUvarint() (r Result[uint64]) {
+	v, n := binary.Uvarint(bb.Bytes())
+	if n == 0 {
+		return r.Err(io.ErrUnexpectedEOF)
+	}
+
+	if n < 0 {
+		return r.Err(ErrVarintOverflow)
+	}
+
+	bb.Next(n)
+
+	return r.Ok(v)
+}
+
+// ReadVarint decodes a signed (zig-zag) varint.
+//
+// If the input is truncated, the cursor is not moved and the Result holds io.ErrUnexpectedEOF.
+func (bb *BytesBuffer) ReadVarint() (r Result[int64]) {
+	v, n := binary.Varint(bb.Bytes())
+	if n == 0 {
+		return r.Err(io.ErrUnexpectedEOF)
+	}
+
+	if n < 0 {
+		return r.Err(ErrVarintOverflow)
+	}
+
+	bb.Next(n)
+
+	return r.Ok(v)
+}
+
+// ReadUint16 decodes a 2 bytes unsigned integer using `order`.
+func (bb *BytesBuffer) ReadUint16(order ByteOrder) Result[uint16] {
+	return MapResult(MakeResult(bb.readFixed(2)), func(b Bytes) uint16 {
+		return order.Uint16(b)
+	})
+}
+
+// ReadUint32 decodes a 4 bytes unsigned integer using `order`.
+func (bb *BytesBuffer) ReadUint32(order ByteOrder) Result[uint32] {
+	return MapResult(MakeResult(bb.readFixed(4)), func(b Bytes) uint32 {
+		return order.Uint32(b)
+	})
+}
+
+// ReadUint64 decodes an 8 bytes unsigned integer using `order`.
+func (bb *BytesBuffer) ReadUint64(order ByteOrder) Result[uint64] {
+	return MapResult(MakeResult(bb.readFixed(8)), func(b Bytes) uint64 {
+		return order.Uint64(b)
+	})
+}
+
+// ReadFloat32 decodes an IEEE 754 single precision number using `order`.
+func (bb *BytesBuffer) ReadFloat32(order ByteOrder) Result[float32] {
+	return MapResult(bb.ReadUint32(order), math.Float32frombits)
+}
+
+// ReadFloat64 decodes an IEEE 754 double precision number using `order`.
+func (bb *BytesBuffer) ReadFloat64(order ByteOrder) Result[float64] {
+	return MapResult(bb.ReadUint64(order), math.Float64frombits)
+}
+
+// ReadPrefixedBytes decodes bytes preceded by their length encoded as an unsigned varint.
+//
+// The returned Bytes share the buffer's storage. If the input is truncated,
+// the cursor is not moved and the Result holds io.ErrUnexpectedEOF.
+func (bb *BytesBuffer) ReadPrefixedBytes() (r Result[Bytes]) {
+	size, n := binary.Uvarint(bb.Bytes())
+	if n == 0 {
+		return r.Err(io.ErrUnexpectedEOF)
+	}
+
+	if n < 0 {
+		return r.Err(ErrVarintOverflow)
+	}
+
+	if uint64(bb.Len()-n) < size {
+		return r.Err(io.ErrUnexpectedEOF)
+	}
+
+	bb.Next(n)
+
+	return r.Ok(bb.Next(int(size)))
+}
+
+// ReadPrefixedString decodes a string preceded by its length encoded as an unsigned varint.
+func (bb *BytesBuffer) ReadPrefixedString() Result[string] {
+	return MapResult(bb.ReadPrefixedBytes(), Bytes.String)
+}
@@ -0,0 +1,86 @@
+package gtl
+
+import (
+	"encoding/binary"
+	"io"
+	"testing"
+)
+
+func TestBytesBinary(t *testing.T) {
+	var b Bytes
+
+	b.AppendUvarint(300)
+	b.AppendVarint(-2)
+	b.AppendUint16(binary.BigEndian, 0x0102)
+	b.AppendUint32(binary.LittleEndian, 0x01020304)
+	b.AppendUint64(binary.BigEndian, 1<<40)
+	b.AppendFloat32(binary.LittleEndian, 1.5)
+	b.AppendFloat64(binary.BigEndian, -2.25)
+	b.AppendPrefixedString("hello")
+
+	if b[3] != 0x01 || b[4] != 0x02 || b[5] != 0x04 {
+		t.Fatalf("unexpected encoding: %v", b)
+	}
+
+	bb := NewBytesBuffer(b)
+
+	if v := bb.ReadUvarint(); v.Get() != 300 {
+		t.Fatalf("expected 300, got %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadVarint(); v.Get() != -2 {
+		t.Fatalf("expected -2, got %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadUint16(binary.BigEndian); v.Get() != 0x0102 {
+		t.Fatalf("unexpected uint16: %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadUint32(binary.LittleEndian); v.Get() != 0x01020304 {
+		t.Fatalf("unexpected uint32: %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadUint64(binary.BigEndian); v.Get() != 1<<40 {
+		t.Fatalf("unexpected uint64: %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadFloat32(binary.LittleEndian); v.Get() != 1.5 {
+		t.Fatalf("unexpected float32: %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadFloat64(binary.BigEndian); v.Get() != -2.25 {
+		t.Fatalf("unexpected float64: %v %v", v.Get(), v.Error())
+	}
+
+	if v := bb.ReadPrefixedString(); v.Get() != "hello" {
+		t.Fatalf("unexpected string: %v %v", v.Get(), v.Error())
+	}
+
+	if bb.Len() != 0 {
+		t.Fatalf("expected no unread bytes, got %d", bb.Len())
+	}
+}
+
+func TestBytesBinaryTruncated(t *testing.T) {
+	var b Bytes
+	b.AppendPrefixedString("hello")
+
+	bb := NewBytesBuffer(b[:4])
+
+	if r := bb.ReadPrefixedString(); r.Error() != io.ErrUnexpectedEOF {
+		t.Fatalf("expected ErrUnexpectedEOF, got %v", r.Error())
+	}
+
+	if r := bb.ReadUint64(binary.BigEndian); r.Error() != io.ErrUnexpectedEOF {
+		t.Fatalf("expected ErrUnexpectedEOF, got %v", r.Error())
+	}
+
+	if bb.Len() != 4 {
+		t.Fatal("a truncated read moved the cursor")
+	}
+
+	bb = NewBytesBuffer(Bytes{0xff, 0xff})
+	if r := bb.ReadUvarint(); r.Error() != io.ErrUnexpectedEOF {
+		t.Fatalf("expected ErrUnexpectedEOF, got %v", r.Error())
+	}
+}
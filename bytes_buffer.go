@@ -0,0 +1,326 @@
+package gtl
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+var (
+	_ io.Reader      = (*BytesBuffer)(nil)
+	_ io.Writer      = (*BytesBuffer)(nil)
+	_ io.ByteScanner = (*BytesBuffer)(nil)
+	_ io.RuneScanner = (*BytesBuffer)(nil)
+	_ io.Seeker      = (*BytesBuffer)(nil)
+	_ io.ReaderAt    = (*BytesBuffer)(nil)
+	_ io.ReaderFrom  = (*BytesBuffer)(nil)
+	_ io.WriterTo    = (*BytesBuffer)(nil)
+)
+
+var (
+	// ErrInvalidUnread is returned when UnreadByte or UnreadRune can't be performed.
+	ErrInvalidUnread = errors.New("gtl: invalid unread")
+	// ErrNegativeOffset is returned when seeking or reading at a negative offset.
+	ErrNegativeOffset = errors.New("gtl: negative offset")
+)
+
+// minFillSize is the minimum free space reserved by Fill before reading.
+const minFillSize = 512
+
+// BytesBuffer is a Bytes with a read cursor.
+//
+// The data is written at the end of the buffer and read from the cursor,
+// keeping track of how many bytes are valid.
+type BytesBuffer struct {
+	b Bytes
+	r int
+	// lastRead is the size of the last byte or rune read, used by UnreadByte and UnreadRune.
+	lastRead int
+}
+
+// NewBytesBuffer returns a BytesBuffer holding `b` as unread data.
+func NewBytesBuffer(b Bytes) *BytesBuffer {
+	return &BytesBuffer{
+		b: b,
+	}
+}
+
+// Bytes returns the unread bytes. The returned Bytes are valid until the next modification of the buffer.
+func (bb *BytesBuffer) Bytes() Bytes {
+	return bb.b[bb.r:]
+}
+
+// Len returns the number of unread bytes.
+func (bb *BytesBuffer) Len() int {
+	return len(bb.b) - bb.r
+}
+
+// Cap returns the capacity of the underlying Bytes.
+func (bb *BytesBuffer) Cap() int {
+	return cap(bb.b)
+}
+
+// Reset empties the buffer keeping the underlying storage.
+func (bb *BytesBuffer) Reset() {
+	bb.b = bb.b[:0]
+	bb.r = 0
+	bb.lastRead = 0
+}
+
+// Compact discards the bytes already read, moving the unread bytes to the beginning of the buffer.
+//
+// The offsets used by Seek and ReadAt are relative to the new beginning after calling Compact.
+func (bb *BytesBuffer) Compact() {
+	if bb.r == 0 {
+		return
+	}
+
+	n := copy(bb.b, bb.b[bb.r:])
+	bb.b = bb.b[:n]
+	bb.r = 0
+	bb.lastRead = 0
+}
+
+// grow ensures there's space to write `n` more bytes without allocating.
+func (bb *BytesBuffer) grow(n int) {
+	if cap(bb.b)-len(bb.b) < n {
+		bb.b = append(bb.b, make([]byte, n)...)[:len(bb.b)]
+	}
+}
+
+// Write appends `p` to the buffer.
+func (bb *BytesBuffer) Write(p []byte) (int, error) {
+	bb.b.Append(p...)
+	return len(p), nil
+}
+
+// WriteByte appends `c` to the buffer.
+func (bb *BytesBuffer) WriteByte(c byte) error {
+	bb.b.Append(c)
+	return nil
+}
+
+// WriteString appends `s` to the buffer.
+func (bb *BytesBuffer) WriteString(s string) (int, error) {
+	bb.b = append(bb.b, s...)
+	return len(s), nil
+}
+
+// Read reads up to len(p) unread bytes into `p`.
+//
+// If there are no bytes to read, io.EOF is returned.
+func (bb *BytesBuffer) Read(p []byte) (int, error) {
+	bb.lastRead = 0
+
+	if bb.Len() == 0 {
+		if len(p) == 0 {
+			return 0, nil
+		}
+
+		return 0, io.EOF
+	}
+
+	n := copy(p, bb.b[bb.r:])
+	bb.r += n
+
+	return n, nil
+}
+
+// ReadByte reads the next unread byte.
+func (bb *BytesBuffer) ReadByte() (byte, error) {
+	if bb.Len() == 0 {
+		bb.lastRead = 0
+		return 0, io.EOF
+	}
+
+	c := bb.b[bb.r]
+	bb.r++
+	bb.lastRead = 1
+
+	return c, nil
+}
+
+// UnreadByte moves the cursor back one byte.
+//
+// Only the last byte or rune read can be unread.
+func (bb *BytesBuffer) UnreadByte() error {
+	if bb.lastRead <= 0 {
+		return ErrInvalidUnread
+	}
+
+	bb.r--
+	bb.lastRead = 0
+
+	return nil
+}
+
+// ReadRune reads the next UTF-8 encoded rune.
+func (bb *BytesBuffer) ReadRune() (r rune, size int, err error) {
+	if bb.Len() == 0 {
+		bb.lastRead = 0
+		return 0, 0, io.EOF
+	}
+
+	r, size = utf8.DecodeRune(bb.b[bb.r:])
+	bb.r += size
+	bb.lastRead = size
+
+	return r, size, nil
+}
+
+// UnreadRune moves the cursor back to the beginning of the last rune read.
+//
+// Only the last byte or rune read can be unread.
+func (bb *BytesBuffer) UnreadRune() error {
+	if bb.lastRead <= 0 {
+		return ErrInvalidUnread
+	}
+
+	bb.r -= bb.lastRead
+	bb.lastRead = 0
+
+	return nil
+}
+
+// Seek sets the read cursor. The offset is relative to the beginning of the buffer (io.SeekStart),
+// to the current cursor (io.SeekCurrent) or to the end of the written data (io.SeekEnd).
+//
+// Seeking past the end places the cursor at the end. The returned position is the resulting cursor.
+func (bb *BytesBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += int64(bb.r)
+	case io.SeekEnd:
+		offset += int64(len(bb.b))
+	default:
+		return 0, errors.New("gtl: invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	bb.r = int(Min(offset, int64(len(bb.b))))
+	bb.lastRead = 0
+
+	return int64(bb.r), nil
+}
+
+// ReadAt reads len(p) bytes starting at the offset `off` of the buffer, without moving the cursor.
+func (bb *BytesBuffer) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, ErrNegativeOffset
+	}
+
+	if off >= int64(len(bb.b)) {
+		return 0, io.EOF
+	}
+
+	n := copy(p, bb.b[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+
+	return n, nil
+}
+
+// Peek returns the next `n` unread bytes without moving the cursor.
+//
+// If there are less than `n` unread bytes, the available bytes are returned along with io.EOF.
+// The returned Bytes are valid until the next modification of the buffer.
+func (bb *BytesBuffer) Peek(n int) (Bytes, error) {
+	if n > bb.Len() {
+		return bb.Bytes(), io.EOF
+	}
+
+	return bb.b[bb.r : bb.r+n], nil
+}
+
+// Next returns the next `n` unread bytes (or less if there are not enough) and moves the cursor.
+//
+// The returned Bytes are valid until the next modification of the buffer.
+func (bb *BytesBuffer) Next(n int) Bytes {
+	n = Min(Max(n, 0), bb.Len())
+
+	b := bb.b[bb.r : bb.r+n]
+	bb.r += n
+	bb.lastRead = 0
+
+	return b
+}
+
+// Discard skips the next `n` unread bytes.
+//
+// If there are less than `n` unread bytes, the available bytes are discarded and io.EOF is returned.
+func (bb *BytesBuffer) Discard(n int) (int, error) {
+	discarded := bb.Next(n).Len()
+	if discarded < n {
+		return discarded, io.EOF
+	}
+
+	return discarded, nil
+}
+
+// Fill performs a single read from `r`, appending the data to the buffer.
+//
+// Fill grows the buffer if there's not enough space to read.
+func (bb *BytesBuffer) Fill(r io.Reader) (int, error) {
+	bb.grow(minFillSize)
+
+	n, err := r.Read(bb.b[len(bb.b):cap(bb.b)])
+	bb.b = bb.b[:len(bb.b)+n]
+
+	return n, err
+}
+
+// ReadFull reads from `r` until there are at least `n` unread bytes in the buffer.
+//
+// If `r` returns io.EOF before, io.ErrUnexpectedEOF is returned.
+func (bb *BytesBuffer) ReadFull(r io.Reader, n int) error {
+	bb.grow(n - bb.Len())
+
+	for bb.Len() < n {
+		_, err := bb.Fill(r)
+		if bb.Len() >= n {
+			break
+		}
+
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadFrom reads from `r` until io.EOF, appending the data to the buffer.
+func (bb *BytesBuffer) ReadFrom(r io.Reader) (int64, error) {
+	var total int64
+
+	for {
+		n, err := bb.Fill(r)
+		total += int64(n)
+
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			}
+
+			return total, err
+		}
+	}
+}
+
+// WriteTo writes the unread bytes to `w`, moving the cursor.
+func (bb *BytesBuffer) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(bb.Bytes())
+	bb.r += n
+	bb.lastRead = 0
+
+	return int64(n), err
+}
@@ -0,0 +1,93 @@
+package gtl
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestBytesBuffer(t *testing.T) {
+	var bb BytesBuffer
+
+	bb.WriteString("héllo\nworld")
+
+	if c, _ := bb.ReadByte(); c != 'h' {
+		t.Fatalf("expected h, got %c", c)
+	}
+
+	if r, size, _ := bb.ReadRune(); r != 'é' || size != 2 {
+		t.Fatalf("expected é, got %c", r)
+	}
+
+	if err := bb.UnreadRune(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := bb.UnreadByte(); err != ErrInvalidUnread {
+		t.Fatalf("expected ErrInvalidUnread, got %v", err)
+	}
+
+	if p, err := bb.Peek(4); err != nil || p.String() != "éll" {
+		t.Fatalf("unexpected peek: %s %v", p, err)
+	}
+
+	if n, err := bb.Discard(4); n != 4 || err != nil {
+		t.Fatalf("unexpected discard: %d %v", n, err)
+	}
+
+	line := bb.Next(bb.Bytes().Index('\n') + 1)
+	if line.String() != "o\n" {
+		t.Fatalf("unexpected line: %q", line)
+	}
+
+	if _, err := bb.Seek(-5, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+
+	rest, _ := io.ReadAll(&bb)
+	if string(rest) != "world" {
+		t.Fatalf("unexpected data: %s", rest)
+	}
+
+	end, err := bb.Seek(100, io.SeekStart)
+	if pos, _ := bb.Seek(0, io.SeekCurrent); err != nil || end != pos || end != int64(len(bb.b)) {
+		t.Fatalf("seeking past the end: %d %d %v", end, pos, err)
+	}
+
+	p := make([]byte, 4)
+	if n, err := bb.ReadAt(p, 7); n != 4 || err != nil || string(p) != "worl" {
+		t.Fatalf("unexpected ReadAt: %s %v", p[:n], err)
+	}
+
+	bb.Seek(1, io.SeekStart)
+	bb.Compact()
+
+	if bb.Len() != 11 || bb.Bytes()[0] != 0xc3 {
+		t.Fatalf("unexpected data after compact: %q", bb.Bytes())
+	}
+}
+
+func TestBytesBufferReadFull(t *testing.T) {
+	var bb BytesBuffer
+
+	r := iotest.OneByteReader(strings.NewReader(strings.Repeat("a", 1000)))
+	if err := bb.ReadFull(r, 800); err != nil {
+		t.Fatal(err)
+	}
+
+	if bb.Len() < 800 {
+		t.Fatalf("expected at least 800 bytes, got %d", bb.Len())
+	}
+
+	if err := bb.ReadFull(r, 1200); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected ErrUnexpectedEOF, got %v", err)
+	}
+
+	bb.Reset()
+
+	n, err := bb.ReadFrom(strings.NewReader(strings.Repeat("b", 2000)))
+	if err != nil || n != 2000 || bb.Len() != 2000 {
+		t.Fatalf("unexpected ReadFrom: %d %v", n, err)
+	}
+}
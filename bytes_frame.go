@@ -0,0 +1,263 @@
+package gtl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+var (
+	// ErrFrameTooLarge is returned when a frame exceeds the maximum frame size.
+	ErrFrameTooLarge = errors.New("gtl: frame too large")
+	// ErrFrameSize is returned when writing a frame that doesn't match the fixed frame size.
+	ErrFrameSize = errors.New("gtl: invalid frame size")
+)
+
+// ByteOrder defines a byte order that can decode and append integers,
+// like binary.BigEndian and binary.LittleEndian.
+type ByteOrder interface {
+	binary.ByteOrder
+	binary.AppendByteOrder
+}
+
+// FrameSplitFunc finds the first complete frame in `b`.
+//
+// It returns the frame and the number of bytes of `b` consumed by the frame.
+// If `b` doesn't hold a complete frame yet, `n` must be 0.
+type FrameSplitFunc func(b Bytes) (frame Bytes, n int, err error)
+
+// FrameEncodeFunc appends the encoded `frame` to `dst`.
+type FrameEncodeFunc func(dst Bytes, frame []byte) (Bytes, error)
+
+// Framing defines how frames are read from and written to a stream.
+type Framing struct {
+	Split  FrameSplitFunc
+	Encode FrameEncodeFunc
+}
+
+// DelimFraming returns a Framing where every frame is terminated by `delim`.
+//
+// The frames returned when reading don't include the delimiter.
+func DelimFraming(delim []byte) Framing {
+	return Framing{
+		Split: func(b Bytes) (Bytes, int, error) {
+			i := bytes.Index(b, delim)
+			if i == -1 {
+				return nil, 0, nil
+			}
+
+			return b[:i], i + len(delim), nil
+		},
+		Encode: func(dst Bytes, frame []byte) (Bytes, error) {
+			dst.Append(frame...)
+			dst.Append(delim...)
+
+			return dst, nil
+		},
+	}
+}
+
+// LineFraming returns a Framing where every frame is terminated by `\n` or `\r\n`.
+//
+// The frames are written using `\n` as terminator.
+func LineFraming() Framing {
+	f := DelimFraming([]byte{'\n'})
+	split := f.Split
+
+	f.Split = func(b Bytes) (Bytes, int, error) {
+		frame, n, err := split(b)
+		if n > 0 && len(frame) > 0 && frame[len(frame)-1] == '\r' {
+			frame = frame[:len(frame)-1]
+		}
+
+		return frame, n, err
+	}
+
+	return f
+}
+
+// FixedFraming returns a Framing where every frame has exactly `size` bytes.
+func FixedFraming(size int) Framing {
+	return Framing{
+		Split: func(b Bytes) (Bytes, int, error) {
+			if len(b) < size {
+				return nil, 0, nil
+			}
+
+			return b[:size], size, nil
+		},
+		Encode: func(dst Bytes, frame []byte) (Bytes, error) {
+			if len(frame) != size {
+				return dst, ErrFrameSize
+			}
+
+			dst.Append(frame...)
+
+			return dst, nil
+		},
+	}
+}
+
+// LengthPrefixFraming returns a Framing where every frame is preceded by its length,
+// encoded as an unsigned integer of `prefixSize` bytes (1, 2, 4 or 8) using `order`.
+//
+// Frames longer than `maxSize` bytes are rejected. If `maxSize` <= 0, only the prefix size limits the frame length.
+func LengthPrefixFraming(prefixSize int, order ByteOrder, maxSize int) Framing {
+	if prefixSize != 1 && prefixSize != 2 && prefixSize != 4 && prefixSize != 8 {
+		panic(fmt.Sprintf("gtl: invalid length prefix size %d", prefixSize))
+	}
+
+	limit := uint64(math.MaxUint64)
+	if prefixSize < 8 {
+		limit = uint64(1)<<(8*prefixSize) - 1
+	}
+
+	// the frame including its prefix must be addressable by an int
+	limit = Min(limit, uint64(math.MaxInt-prefixSize))
+
+	if maxSize > 0 {
+		limit = Min(limit, uint64(maxSize))
+	}
+
+	return Framing{
+		Split: func(b Bytes) (Bytes, int, error) {
+			if len(b) < prefixSize {
+				return nil, 0, nil
+			}
+
+			var size uint64
+
+			switch prefixSize {
+			case 1:
+				size = uint64(b[0])
+			case 2:
+				size = uint64(order.Uint16(b))
+			case 4:
+				size = uint64(order.Uint32(b))
+			case 8:
+				size = order.Uint64(b)
+			}
+
+			if size > limit {
+				return nil, 0, ErrFrameTooLarge
+			}
+
+			n := prefixSize + int(size)
+			if len(b) < n {
+				return nil, 0, nil
+			}
+
+			return b[prefixSize:n], n, nil
+		},
+		Encode: func(dst Bytes, frame []byte) (Bytes, error) {
+			size := uint64(len(frame))
+			if size > limit {
+				return dst, ErrFrameTooLarge
+			}
+
+			switch prefixSize {
+			case 1:
+				dst.Append(byte(size))
+			case 2:
+				dst = order.AppendUint16(dst, uint16(size))
+			case 4:
+				dst = order.AppendUint32(dst, uint32(size))
+			case 8:
+				dst = order.AppendUint64(dst, size)
+			}
+
+			dst.Append(frame...)
+
+			return dst, nil
+		},
+	}
+}
+
+// FrameReader reads frames from an io.Reader.
+type FrameReader struct {
+	r       io.Reader
+	buf     BytesBuffer
+	split   FrameSplitFunc
+	maxSize int
+	err     error
+}
+
+// NewFrameReader returns a FrameReader that reads frames from `r` as defined by `f`.
+//
+// If `maxSize` > 0, ReadFrame fails with ErrFrameTooLarge when more than `maxSize` bytes
+// are buffered without finding a complete frame.
+func NewFrameReader(r io.Reader, f Framing, maxSize int) *FrameReader {
+	return &FrameReader{
+		r:       r,
+		split:   f.Split,
+		maxSize: maxSize,
+	}
+}
+
+// ReadFrame returns the next complete frame.
+//
+// The returned frame shares the reader's buffer, so it is only valid until the next call to ReadFrame.
+// If the stream ends in the middle of a frame, io.ErrUnexpectedEOF is returned.
+func (fr *FrameReader) ReadFrame() (Bytes, error) {
+	for {
+		frame, n, err := fr.split(fr.buf.Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		if n > 0 {
+			fr.buf.Next(n)
+			return frame, nil
+		}
+
+		if fr.maxSize > 0 && fr.buf.Len() > fr.maxSize {
+			return nil, ErrFrameTooLarge
+		}
+
+		if fr.err != nil {
+			if fr.err == io.EOF && fr.buf.Len() != 0 {
+				return nil, io.ErrUnexpectedEOF
+			}
+
+			return nil, fr.err
+		}
+
+		fr.buf.Compact()
+		_, fr.err = fr.buf.Fill(fr.r)
+	}
+}
+
+// Buffered returns the bytes read from the stream that haven't been returned as a frame yet.
+func (fr *FrameReader) Buffered() Bytes {
+	return fr.buf.Bytes()
+}
+
+// FrameWriter writes frames to an io.Writer.
+type FrameWriter struct {
+	w      io.Writer
+	buf    Bytes
+	encode FrameEncodeFunc
+}
+
+// NewFrameWriter returns a FrameWriter that writes frames to `w` as defined by `f`.
+func NewFrameWriter(w io.Writer, f Framing) *FrameWriter {
+	return &FrameWriter{
+		w:      w,
+		encode: f.Encode,
+	}
+}
+
+// WriteFrame encodes `frame` and writes it to the underlying writer using a single call to Write.
+func (fw *FrameWriter) WriteFrame(frame []byte) (err error) {
+	fw.buf, err = fw.encode(fw.buf[:0], frame)
+	if err != nil {
+		return err
+	}
+
+	_, err = fw.w.Write(fw.buf)
+
+	return err
+}
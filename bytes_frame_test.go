@@ -0,0 +1,106 @@
+package gtl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFrameLines(t *testing.T) {
+	r := iotest.HalfReader(strings.NewReader("hello\r\nworld\n\nlast"))
+	fr := NewFrameReader(r, LineFraming(), 0)
+
+	for _, expected := range []string{"hello", "world", ""} {
+		frame, err := fr.ReadFrame()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if frame.String() != expected {
+			t.Fatalf("expected %q, got %q", expected, frame)
+		}
+	}
+
+	if _, err := fr.ReadFrame(); err != io.ErrUnexpectedEOF {
+		t.Fatalf("expected ErrUnexpectedEOF, got %v", err)
+	}
+
+	if fr.Buffered().String() != "last" {
+		t.Fatalf("unexpected buffered data: %q", fr.Buffered())
+	}
+}
+
+func TestFrameMaxSize(t *testing.T) {
+	r := strings.NewReader(strings.Repeat("a", 2048) + "\n")
+	fr := NewFrameReader(r, LineFraming(), 1024)
+
+	if _, err := fr.ReadFrame(); err != ErrFrameTooLarge {
+		t.Fatalf("expected ErrFrameTooLarge, got %v", err)
+	}
+}
+
+func TestFrameOversizedPrefix(t *testing.T) {
+	f := LengthPrefixFraming(8, binary.BigEndian, 0)
+
+	b := binary.BigEndian.AppendUint64(nil, math.MaxInt64)
+	if _, _, err := f.Split(append(b, "data"...)); err != ErrFrameTooLarge {
+		t.Fatalf("expected ErrFrameTooLarge, got %v", err)
+	}
+
+	b = binary.BigEndian.AppendUint64(nil, math.MaxInt64-8)
+	if frame, n, err := f.Split(append(b, "data"...)); frame != nil || n != 0 || err != nil {
+		t.Fatalf("expected an incomplete frame, got %q %d %v", frame, n, err)
+	}
+}
+
+func TestFrameRoundTrip(t *testing.T) {
+	framings := map[string]Framing{
+		"delim":  DelimFraming([]byte("\r\n")),
+		"fixed":  FixedFraming(5),
+		"prefix": LengthPrefixFraming(2, binary.BigEndian, 16),
+		"le":     LengthPrefixFraming(4, binary.LittleEndian, 0),
+	}
+
+	frames := []string{"hello", "world", "12345"}
+
+	for name, f := range framings {
+		var stream bytes.Buffer
+
+		fw := NewFrameWriter(&stream, f)
+		for _, frame := range frames {
+			if err := fw.WriteFrame([]byte(frame)); err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+		}
+
+		fr := NewFrameReader(iotest.OneByteReader(&stream), f, 0)
+		for _, expected := range frames {
+			frame, err := fr.ReadFrame()
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+
+			if frame.String() != expected {
+				t.Fatalf("%s: expected %q, got %q", name, expected, frame)
+			}
+		}
+
+		if _, err := fr.ReadFrame(); err != io.EOF {
+			t.Fatalf("%s: expected EOF, got %v", name, err)
+		}
+	}
+
+	fw := NewFrameWriter(io.Discard, LengthPrefixFraming(1, binary.BigEndian, 4))
+	if err := fw.WriteFrame([]byte("hello")); err != ErrFrameTooLarge {
+		t.Fatalf("expected ErrFrameTooLarge, got %v", err)
+	}
+
+	fr := NewFrameReader(bytes.NewReader([]byte{0, 0, 1, 0}), LengthPrefixFraming(4, binary.BigEndian, 128), 0)
+	if _, err := fr.ReadFrame(); err != ErrFrameTooLarge {
+		t.Fatalf("expected ErrFrameTooLarge, got %v", err)
+	}
+}
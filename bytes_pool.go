@@ -0,0 +1,105 @@
+package gtl
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+// BytesPoolStats holds the counters of a BytesPool.
+type BytesPoolStats struct {
+	// Hits is the number of Get calls served with a pooled Bytes.
+	Hits uint64
+	// Misses is the number of Get calls that had to allocate.
+	Misses uint64
+	// Drops is the number of Put calls that discarded the Bytes because its capacity was out of range.
+	Drops uint64
+}
+
+// BytesPool is a pool of Bytes grouped in power-of-two capacity classes.
+//
+// A BytesPool is safe for concurrent use.
+type BytesPool struct {
+	minShift int
+	maxShift int
+	classes  []sync.Pool
+	// headers recycles the pointers stored in the classes, so Put doesn't allocate.
+	headers sync.Pool
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	drops  atomic.Uint64
+}
+
+// NewBytesPool returns a BytesPool handing out Bytes with capacities from `minSize` up to `maxSize`.
+//
+// Both sizes are rounded up to a power of two.
+func NewBytesPool(minSize, maxSize int) *BytesPool {
+	minShift := bits.Len(uint(Max(minSize, 1) - 1))
+	maxShift := Max(bits.Len(uint(Max(maxSize, 1)-1)), minShift)
+
+	return &BytesPool{
+		minShift: minShift,
+		maxShift: maxShift,
+		classes:  make([]sync.Pool, maxShift-minShift+1),
+	}
+}
+
+// Get returns a Bytes with len = `size`. The capacity is the smallest power-of-two class that fits `size`.
+//
+// If `size` exceeds the maximum size of the pool, a new Bytes is allocated and it won't be pooled on Put.
+func (p *BytesPool) Get(size int) Bytes {
+	shift := Max(bits.Len(uint(size-1)), p.minShift)
+	if size <= 0 {
+		shift = p.minShift
+	}
+
+	if shift > p.maxShift {
+		p.misses.Add(1)
+		return NewBytes(size, size)
+	}
+
+	if h, _ := p.classes[shift-p.minShift].Get().(*Bytes); h != nil {
+		b := *h
+		*h = nil
+		p.headers.Put(h)
+
+		p.hits.Add(1)
+
+		return b[:size]
+	}
+
+	p.misses.Add(1)
+
+	return NewBytes(size, 1<<shift)
+}
+
+// Put returns `b` to the pool. `b` must not be used after calling Put.
+//
+// If the capacity of `b` is out of the range of the pool, `b` is dropped.
+func (p *BytesPool) Put(b Bytes) {
+	// the class is the largest that fits in the capacity, so larger capacities must be checked explicitly.
+	shift := bits.Len(uint(b.Cap())) - 1
+	if shift < p.minShift || b.Cap() > 1<<p.maxShift {
+		p.drops.Add(1)
+		return
+	}
+
+	h, _ := p.headers.Get().(*Bytes)
+	if h == nil {
+		h = new(Bytes)
+	}
+
+	*h = b[:0]
+
+	p.classes[shift-p.minShift].Put(h)
+}
+
+// Stats returns the counters of the pool.
+func (p *BytesPool) Stats() BytesPoolStats {
+	return BytesPoolStats{
+		Hits:   p.hits.Load(),
+		Misses: p.misses.Load(),
+		Drops:  p.drops.Load(),
+	}
+}
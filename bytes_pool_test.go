@@ -0,0 +1,78 @@
+package gtl
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBytesPool(t *testing.T) {
+	pool := NewBytesPool(64, 1000)
+
+	b := pool.Get(100)
+	if b.Len() != 100 || b.Cap() != 128 {
+		t.Fatalf("unexpected len and cap: %d %d", b.Len(), b.Cap())
+	}
+
+	if b := pool.Get(10); b.Cap() != 64 {
+		t.Fatalf("expected the minimum capacity, got %d", b.Cap())
+	}
+
+	large := pool.Get(2000)
+	if large.Cap() != 2000 {
+		t.Fatalf("expected an exact allocation, got %d", large.Cap())
+	}
+
+	pool.Put(large)
+	pool.Put(NewBytes(0, 4096))
+	pool.Put(NewBytes(0, 16))
+
+	if stats := pool.Stats(); stats.Misses != 3 || stats.Drops != 3 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	if b := pool.Get(1000); b.Cap() != 1024 {
+		t.Fatalf("expected the maximum class capacity, got %d", b.Cap())
+	}
+}
+
+func TestBytesPoolConcurrent(t *testing.T) {
+	pool := NewBytesPool(16, 1<<16)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			for j := 0; j < 1000; j++ {
+				b := pool.Get(j * (i + 1))
+				if b.Len() != j*(i+1) {
+					t.Errorf("unexpected len: %d", b.Len())
+					return
+				}
+
+				pool.Put(b)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	stats := pool.Stats()
+	if stats.Hits+stats.Misses != 8000 || stats.Hits == 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func BenchmarkBytesPool(b *testing.B) {
+	pool := NewBytesPool(128, 1<<16)
+
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			bts := pool.Get(4096)
+			pool.Put(bts)
+		}
+	})
+}
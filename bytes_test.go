@@ -0,0 +1,98 @@
+package gtl
+
+import (
+	"testing"
+)
+
+func collectStrings(it Iterator[Bytes]) (r []string) {
+	for it.Next() {
+		r = append(r, it.Get().String())
+	}
+
+	return r
+}
+
+func expectStrings(t *testing.T, values []string, expected ...string) {
+	t.Helper()
+
+	if len(values) != len(expected) {
+		t.Fatalf("expected %q, got %q", expected, values)
+	}
+
+	for i := range expected {
+		if values[i] != expected[i] {
+			t.Fatalf("expected %q, got %q", expected, values)
+		}
+	}
+}
+
+func TestBytesSearch(t *testing.T) {
+	b := BytesFrom([]byte("key: value: ñ"))
+
+	if b.IndexBytes([]byte(": ")) != 3 || b.LastIndex([]byte(": ")) != 10 {
+		t.Fatal("unexpected index")
+	}
+
+	if b.IndexAny("ñv") != 5 || b.IndexRune('ñ') != 12 || b.LastIndexByte('e') != 9 {
+		t.Fatal("unexpected index")
+	}
+
+	if !b.HasPrefix([]byte("key")) || !b.HasSuffix([]byte("ñ")) || !b.ContainsBytes([]byte("val")) {
+		t.Fatal("unexpected prefix or suffix")
+	}
+
+	before, after, found := b.Cut([]byte(": "))
+	if !found || before.String() != "key" || after.String() != "value: ñ" {
+		t.Fatalf("unexpected cut: %q %q", before, after)
+	}
+
+	if _, _, found := b.Cut([]byte("none")); found {
+		t.Fatal("unexpected cut")
+	}
+}
+
+func TestBytesSplit(t *testing.T) {
+	b := BytesFrom([]byte("a,b,,c"))
+
+	expectStrings(t, collectStrings(b.Split([]byte(","))), "a", "b", "", "c")
+	expectStrings(t, collectStrings(BytesFrom([]byte("añ")).Split(nil)), "a", "ñ")
+	expectStrings(t, collectStrings(BytesFrom(nil).Split([]byte(","))), "")
+	expectStrings(t, collectStrings(BytesFrom(nil).Split(nil)))
+	expectStrings(t, collectStrings(BytesFrom([]byte("  hello \t world\n")).Fields()), "hello", "world")
+	expectStrings(t, collectStrings(BytesFrom([]byte(" ")).Fields()))
+}
+
+func TestBytesTransform(t *testing.T) {
+	b := BytesFrom([]byte("  Hello Ñandú  "))
+
+	b.TrimSpace()
+	b.ToUpper()
+
+	if b.String() != "HELLO ÑANDÚ" {
+		t.Fatalf("unexpected upper: %s", b)
+	}
+
+	b.ToLower()
+	if b.String() != "hello ñandú" {
+		t.Fatalf("unexpected lower: %s", b)
+	}
+
+	b = BytesFrom([]byte("ıi"))
+	b.ToUpper()
+
+	if b.String() != "II" {
+		t.Fatalf("unexpected upper: %s", b)
+	}
+
+	b = BytesFrom([]byte("a-b-c"))
+	b.ReplaceAll([]byte("-"), []byte("+"))
+
+	if b.String() != "a+b+c" {
+		t.Fatalf("unexpected replace: %s", b)
+	}
+
+	b.ReplaceAll([]byte("+"), []byte(" + "))
+	if b.String() != "a + b + c" {
+		t.Fatalf("unexpected replace: %s", b)
+	}
+}
@@ -1,48 +1,228 @@
 package gtl
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"sync/atomic"
+	"time"
 )
 
+var (
+	// ErrClosed is returned when sending to or receiving from a closed channel.
+	ErrClosed = errors.New("gtl: channel closed")
+	// ErrFull is returned when sending without blocking to a channel with a full buffer.
+	ErrFull = errors.New("gtl: channel full")
+	// ErrTimeout is returned when a channel operation doesn't complete in time.
+	ErrTimeout = errors.New("gtl: channel operation timed out")
+)
+
+// chanState is the close state shared by a Channel and its Sender and Receiver.
+//
+// The senders hold the read lock while sending, so the channel is never closed in the middle of a send.
+type chanState struct {
+	mu     sync.RWMutex
+	once   sync.Once
+	closed atomic.Bool
+	done   chan struct{}
+}
+
+func newChanState() *chanState {
+	return &chanState{
+		done: make(chan struct{}),
+	}
+}
+
+// acquire prevents the channel from being closed until release is called.
+// Returns false if the channel is already closed.
+func (st *chanState) acquire() bool {
+	st.mu.RLock()
+	if st.closed.Load() {
+		st.mu.RUnlock()
+		return false
+	}
+
+	return true
+}
+
+func (st *chanState) release() {
+	st.mu.RUnlock()
+}
+
+// close marks the state as closed and calls `fn` once no sender is in the middle of a send.
+//
+// Only the first call has effect.
+func (st *chanState) close(fn func()) {
+	st.once.Do(func() {
+		st.closed.Store(true)
+		// wake up the senders blocked waiting for space
+		close(st.done)
+
+		st.mu.Lock()
+		fn()
+		st.mu.Unlock()
+	})
+}
+
+func (st *chanState) isClosed() bool {
+	return st != nil && st.closed.Load()
+}
+
+func (st *chanState) doneCh() <-chan struct{} {
+	if st == nil {
+		return nil
+	}
+
+	return st.done
+}
+
 type Sender[T any] struct {
 	ch chan<- T
+	st *chanState
+	ub *unbounded[T]
 }
 
+// MakeSender returns a Sender for `ch`.
+//
+// The close state is not shared with other Senders made from the same channel.
+// Use MakeChan and Split to share the close state.
 func MakeSender[T any](ch chan<- T) Sender[T] {
 	return Sender[T]{
 		ch: ch,
+		st: newChanState(),
 	}
 }
 
+// Get returns the underlying channel.
+//
+// Senders created by MakeUnboundedChan don't have an input channel, so Get returns nil.
 func (s Sender[T]) Get() chan<- T {
 	return s.ch
 }
 
-func (s Sender[T]) Send(data T) bool {
+// Send sends `data` without blocking.
+//
+// Returns ErrFull if the channel's buffer is full, or ErrClosed if the channel is closed.
+func (s Sender[T]) Send(data T) error {
+	if s.ub != nil {
+		return s.sendUnbounded(data)
+	}
+
+	if !s.st.acquire() {
+		return ErrClosed
+	}
+	defer s.st.release()
+
 	select {
 	case s.ch <- data:
-		return true
+		return nil
 	default:
-		return false
+		return ErrFull
 	}
 }
 
+// SendCtx sends `data` blocking until the value is sent or `ctx` is done.
+//
+// Returns ErrClosed if the channel is closed, or the context's error if `ctx` is done before sending.
+func (s Sender[T]) SendCtx(ctx context.Context, data T) error {
+	if s.ub != nil {
+		return s.sendUnbounded(data)
+	}
+
+	if !s.st.acquire() {
+		return ErrClosed
+	}
+	defer s.st.release()
+
+	select {
+	case s.ch <- data:
+		return nil
+	case <-s.st.done:
+		return ErrClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// SendTimeout sends `data` blocking at most `timeout`.
+//
+// Returns ErrClosed if the channel is closed, or ErrTimeout if the value couldn't be sent in time.
+func (s Sender[T]) SendTimeout(data T, timeout time.Duration) error {
+	if s.ub != nil {
+		return s.sendUnbounded(data)
+	}
+
+	if !s.st.acquire() {
+		return ErrClosed
+	}
+	defer s.st.release()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case s.ch <- data:
+		return nil
+	case <-s.st.done:
+		return ErrClosed
+	case <-timer.C:
+		return ErrTimeout
+	}
+}
+
+func (s Sender[T]) sendUnbounded(data T) error {
+	if !s.ub.push(data) {
+		return ErrClosed
+	}
+
+	return nil
+}
+
 func (s Sender[T]) Len() int {
+	if s.ub != nil {
+		return s.ub.len()
+	}
+
 	return len(s.ch)
 }
 
+// Close closes the channel. Closing an already closed channel has no effect.
 func (s Sender[T]) Close() error {
-	close(s.ch)
+	s.st.close(func() {
+		if s.ub != nil {
+			s.ub.close()
+		} else {
+			close(s.ch)
+		}
+	})
+
 	return nil
 }
 
+// IsClosed returns whether the channel has been closed.
+func (s Sender[T]) IsClosed() bool {
+	return s.st.isClosed()
+}
+
+// Done returns a channel that is closed when the channel is closed.
+func (s Sender[T]) Done() <-chan struct{} {
+	return s.st.doneCh()
+}
+
 type Receiver[T any] struct {
 	ch <-chan T
+	st *chanState
+	ub *unbounded[T]
 }
 
+// MakeReceiver returns a Receiver for `ch`.
+//
+// As the close state is not shared with the senders of `ch`, IsClosed and Done
+// don't report when `ch` is closed. Use MakeChan and Split to share the close state.
 func MakeReceiver[T any](ch <-chan T) Receiver[T] {
 	return Receiver[T]{
 		ch: ch,
+		st: newChanState(),
 	}
 }
 
@@ -51,6 +231,67 @@ func (r Receiver[T]) Next() Optional[T] {
 	return OptionalWithCond[T](v, ok)
 }
 
+// RecvCtx receives a value blocking until a value is received or `ctx` is done.
+//
+// The Result holds ErrClosed if the channel is closed, or the context's error if `ctx` is done before receiving.
+func (r Receiver[T]) RecvCtx(ctx context.Context) (res Result[T]) {
+	select {
+	case v, ok := <-r.ch:
+		if !ok {
+			return res.Err(ErrClosed)
+		}
+
+		return res.Ok(v)
+	case <-ctx.Done():
+		return res.Err(ctx.Err())
+	}
+}
+
+// RecvTimeout receives a value blocking at most `timeout`.
+//
+// The Result holds ErrClosed if the channel is closed, or ErrTimeout if no value was received in time.
+func (r Receiver[T]) RecvTimeout(timeout time.Duration) (res Result[T]) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case v, ok := <-r.ch:
+		if !ok {
+			return res.Err(ErrClosed)
+		}
+
+		return res.Ok(v)
+	case <-timer.C:
+		return res.Err(ErrTimeout)
+	}
+}
+
+// TryRecv receives a value without blocking.
+//
+// If no value is available or the channel is closed, an empty Optional is returned.
+func (r Receiver[T]) TryRecv() (opt Optional[T]) {
+	select {
+	case v, ok := <-r.ch:
+		return opt.WithCond(v, ok)
+	default:
+		return opt
+	}
+}
+
+// Iter returns an iterator over the values received from the channel.
+//
+// Next blocks until a value is received, and returns false once the channel is closed.
+func (r Receiver[T]) Iter() Iterator[T] {
+	return iterFrom(func() *T {
+		v, ok := <-r.ch
+		if !ok {
+			return nil
+		}
+
+		return &v
+	})
+}
+
 func (r Receiver[T]) Get() <-chan T {
 	return r.ch
 }
@@ -62,6 +303,10 @@ func (r Receiver[T]) Range(fn func(T)) {
 }
 
 func (r Receiver[T]) Len() int {
+	if r.ub != nil {
+		return r.ub.len()
+	}
+
 	return len(r.ch)
 }
 
@@ -73,14 +318,29 @@ func (r Receiver[T]) RangeBool(fn func(T) bool) {
 	}
 }
 
+// IsClosed returns whether the channel has been closed.
+//
+// The values sent before closing the channel can still be received.
+func (r Receiver[T]) IsClosed() bool {
+	return r.st.isClosed()
+}
+
+// Done returns a channel that is closed when the channel is closed.
+//
+// The values sent before closing the channel can still be received.
+func (r Receiver[T]) Done() <-chan struct{} {
+	return r.st.doneCh()
+}
+
 type Channel[T any] struct {
-	ch     chan T
-	closed int32
+	ch chan T
+	st *chanState
 }
 
 func MakeChan[T any](size int) Channel[T] {
 	return Channel[T]{
 		ch: make(chan T, size),
+		st: newChanState(),
 	}
 }
 
@@ -88,14 +348,39 @@ func (ch Channel[T]) Len() int {
 	return len(ch.ch)
 }
 
+// Split returns the Sender and the Receiver of the channel.
+//
+// The Channel, the Sender and the Receiver share the close state, so the channel
+// can be safely closed from any of them, any number of times.
 func (ch Channel[T]) Split() (Sender[T], Receiver[T]) {
-	return MakeSender(ch.ch), MakeReceiver(ch.ch)
+	return ch.sender(), ch.receiver()
 }
 
-func (ch *Channel[T]) Close() error {
-	if atomic.CompareAndSwapInt32(&ch.closed, 0, 1) {
-		close(ch.ch)
+func (ch Channel[T]) sender() Sender[T] {
+	return Sender[T]{
+		ch: ch.ch,
+		st: ch.st,
 	}
+}
 
-	return nil
+func (ch Channel[T]) receiver() Receiver[T] {
+	return Receiver[T]{
+		ch: ch.ch,
+		st: ch.st,
+	}
+}
+
+// Close closes the channel. Closing an already closed channel has no effect.
+func (ch Channel[T]) Close() error {
+	return ch.sender().Close()
+}
+
+// IsClosed returns whether the channel has been closed.
+func (ch Channel[T]) IsClosed() bool {
+	return ch.st.isClosed()
+}
+
+// Done returns a channel that is closed when the channel is closed.
+func (ch Channel[T]) Done() <-chan struct{} {
+	return ch.st.doneCh()
 }
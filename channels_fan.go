@@ -0,0 +1,234 @@
+package gtl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Merge returns a Receiver that receives the values from all the `receivers`.
+//
+// The returned Receiver is closed once all the `receivers` are closed or `ctx` is done.
+func Merge[T any](ctx context.Context, receivers ...Receiver[T]) Receiver[T] {
+	out := MakeChan[T](0)
+
+	var wg sync.WaitGroup
+
+	wg.Add(len(receivers))
+
+	for _, r := range receivers {
+		go func(r Receiver[T]) {
+			defer wg.Done()
+
+			for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+				if !sendCtx(ctx, out.ch, res.Get()) {
+					return
+				}
+			}
+		}(r)
+	}
+
+	go func() {
+		wg.Wait()
+		out.Close()
+	}()
+
+	return out.receiver()
+}
+
+// FanOutMode defines how FanOut distributes the values.
+type FanOutMode int
+
+const (
+	// FanOutRoundRobin sends every value to the next Receiver in order,
+	// waiting for it if it is busy.
+	FanOutRoundRobin FanOutMode = iota
+	// FanOutLoadBalance sends every value to the first Receiver ready to receive it.
+	FanOutLoadBalance
+)
+
+// FanOut distributes the values received from `r` among `n` Receivers.
+//
+// All the returned Receivers are closed once `r` is closed or `ctx` is done. FanOut panics if `n` <= 0.
+func FanOut[T any](ctx context.Context, r Receiver[T], n int, mode FanOutMode) []Receiver[T] {
+	if n <= 0 {
+		panic(fmt.Sprintf("gtl: invalid number of receivers %d", n))
+	}
+
+	outs := make([]Channel[T], n)
+	receivers := make([]Receiver[T], n)
+
+	for i := range outs {
+		outs[i] = MakeChan[T](0)
+		receivers[i] = outs[i].receiver()
+	}
+
+	if mode == FanOutLoadBalance {
+		// every forwarder holds at most one value,
+		// so the values go to whoever receives first.
+		for _, out := range outs {
+			go func(out Channel[T]) {
+				defer out.Close()
+
+				for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+					if !sendCtx(ctx, out.ch, res.Get()) {
+						return
+					}
+				}
+			}(out)
+		}
+
+		return receivers
+	}
+
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				out.Close()
+			}
+		}()
+
+		i := 0
+		for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+			if !sendCtx(ctx, outs[i].ch, res.Get()) {
+				return
+			}
+
+			i = (i + 1) % n
+		}
+	}()
+
+	return receivers
+}
+
+// OverflowPolicy defines what a Broadcaster does when a subscriber's buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowBlock waits until the subscriber has space in its buffer, blocking the rest of subscribers.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest discards the oldest value in the subscriber's buffer to make space for the new one.
+	OverflowDropOldest
+	// OverflowDropNewest discards the new value.
+	OverflowDropNewest
+)
+
+// Broadcaster sends every value received from a Receiver to all its subscribers.
+type Broadcaster[T any] struct {
+	mu sync.Mutex
+	// subs is never modified in place, so it can be iterated without holding mu.
+	subs   []Channel[T]
+	closed bool
+
+	size   int
+	policy OverflowPolicy
+}
+
+// Broadcast returns a Broadcaster sending the values received from `r` to all its subscribers.
+//
+// Every subscriber has a buffer of `size` values. When the buffer is full, the value
+// is handled according to `policy`. The subscribers are closed once `r` is closed.
+func Broadcast[T any](r Receiver[T], size int, policy OverflowPolicy) *Broadcaster[T] {
+	b := &Broadcaster[T]{
+		size:   size,
+		policy: policy,
+	}
+
+	go b.run(r)
+
+	return b
+}
+
+// Subscribe returns a new Receiver that receives the values broadcasted from now on.
+//
+// If the Broadcaster's source is already closed, the returned Receiver is closed.
+func (b *Broadcaster[T]) Subscribe() Receiver[T] {
+	sub := MakeChan[T](b.size)
+
+	b.mu.Lock()
+	if b.closed {
+		sub.Close()
+	} else {
+		subs := make([]Channel[T], len(b.subs), len(b.subs)+1)
+		copy(subs, b.subs)
+		b.subs = append(subs, sub)
+	}
+	b.mu.Unlock()
+
+	return sub.receiver()
+}
+
+// Unsubscribe stops sending values to `r` and closes it.
+//
+// Returns false if `r` is not subscribed to the Broadcaster.
+func (b *Broadcaster[T]) Unsubscribe(r Receiver[T]) bool {
+	b.mu.Lock()
+
+	for j, sub := range b.subs {
+		if (<-chan T)(sub.ch) == r.ch {
+			subs := make([]Channel[T], 0, len(b.subs)-1)
+			b.subs = append(append(subs, b.subs[:j]...), b.subs[j+1:]...)
+			b.mu.Unlock()
+
+			// closing the subscriber unblocks the delivery if it is waiting for it
+			sub.Close()
+
+			return true
+		}
+	}
+
+	b.mu.Unlock()
+
+	return false
+}
+
+// Len returns the number of subscribers.
+func (b *Broadcaster[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return len(b.subs)
+}
+
+func (b *Broadcaster[T]) run(r Receiver[T]) {
+	for v := range r.ch {
+		b.mu.Lock()
+		subs := b.subs
+		b.mu.Unlock()
+
+		for _, sub := range subs {
+			b.deliver(sub, v)
+		}
+	}
+
+	b.mu.Lock()
+	b.closed = true
+	subs := b.subs
+	b.subs = nil
+	b.mu.Unlock()
+
+	for _, sub := range subs {
+		sub.Close()
+	}
+}
+
+// deliver sends `v` to `sub` according to the Broadcaster's policy.
+// Sending to an unsubscribed channel fails with ErrClosed, so `v` is dropped.
+func (b *Broadcaster[T]) deliver(sub Channel[T], v T) {
+	sender := sub.sender()
+
+	switch b.policy {
+	case OverflowBlock:
+		_ = sender.SendCtx(context.Background(), v)
+	case OverflowDropNewest:
+		_ = sender.Send(v)
+	case OverflowDropOldest:
+		// an unbuffered subscriber has no old values to drop
+		for sender.Send(v) == ErrFull && cap(sub.ch) > 0 {
+			select {
+			case <-sub.ch:
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package gtl
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// sendCtx sends `v` to `ch` unless `ctx` is done before. Returns false if `ctx` is done.
+func sendCtx[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// MapChan returns a Receiver that receives the values from `r` passed through `fn`.
+//
+// The error returned by `fn`, or the panic raised by it, is reported in the Result.
+// The returned Receiver is closed when `r` is closed or `ctx` is done.
+func MapChan[T, U any](ctx context.Context, r Receiver[T], fn func(T) (U, error)) Receiver[Result[U]] {
+	out := MakeChan[Result[U]](0)
+
+	go func() {
+		defer out.Close()
+
+		for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+			v := res.Get()
+
+			if !sendCtx(ctx, out.ch, Try(func() (U, error) {
+				return fn(v)
+			})) {
+				return
+			}
+		}
+	}()
+
+	return out.receiver()
+}
+
+// FilterChan returns a Receiver that only receives the values from `r` for which `fn` returns true.
+//
+// The panic raised by `fn` is reported in the Result.
+// The returned Receiver is closed when `r` is closed or `ctx` is done.
+func FilterChan[T any](ctx context.Context, r Receiver[T], fn func(T) bool) Receiver[Result[T]] {
+	out := MakeChan[Result[T]](0)
+
+	go func() {
+		defer out.Close()
+
+		for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+			v := res.Get()
+
+			keep := Try(func() (bool, error) {
+				return fn(v), nil
+			})
+
+			if keep.Error() != nil {
+				res = res.Err(keep.Error())
+			} else if !keep.Get() {
+				continue
+			}
+
+			if !sendCtx(ctx, out.ch, res) {
+				return
+			}
+		}
+	}()
+
+	return out.receiver()
+}
+
+// Batch returns a Receiver that receives the values from `r` grouped in batches of up to `size` values.
+//
+// A batch is sent when it is full or when `maxWait` has passed since its first value was received.
+// If `maxWait` <= 0, batches are only sent when they are full.
+// The returned Receiver is closed when `r` is closed, after sending the last batch, or when `ctx` is done.
+// Batch panics if `size` <= 0.
+func Batch[T any](ctx context.Context, r Receiver[T], size int, maxWait time.Duration) Receiver[[]T] {
+	if size <= 0 {
+		panic(fmt.Sprintf("gtl: invalid batch size %d", size))
+	}
+
+	out := MakeChan[[]T](0)
+
+	go func() {
+		defer out.Close()
+
+		var (
+			batch   = make([]T, 0, size)
+			timer   *time.Timer
+			timeout <-chan time.Time
+		)
+
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeout = nil, nil
+			}
+
+			if len(batch) == 0 {
+				return true
+			}
+
+			ok := sendCtx(ctx, out.ch, batch)
+			batch = make([]T, 0, size)
+
+			return ok
+		}
+
+		for {
+			select {
+			case v, ok := <-r.ch:
+				if !ok {
+					flush()
+					return
+				}
+
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeout = timer.C
+				}
+
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-timeout:
+				timer, timeout = nil, nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				if timer != nil {
+					timer.Stop()
+				}
+
+				return
+			}
+		}
+	}()
+
+	return out.receiver()
+}
+
+// ParallelMap is like MapChan but calls `fn` from `workers` goroutines.
+//
+// If `ordered` is true, the Results are received in the same order as the values were received from `r`.
+// Otherwise, the Results are received as soon as they are ready. ParallelMap panics if `workers` <= 0.
+func ParallelMap[T, U any](ctx context.Context, r Receiver[T], workers int, ordered bool, fn func(T) (U, error)) Receiver[Result[U]] {
+	if workers <= 0 {
+		panic(fmt.Sprintf("gtl: invalid number of workers %d", workers))
+	}
+
+	out := MakeChan[Result[U]](0)
+
+	call := func(v T) Result[U] {
+		return Try(func() (U, error) {
+			return fn(v)
+		})
+	}
+
+	if !ordered {
+		var wg sync.WaitGroup
+
+		wg.Add(workers)
+
+		for i := 0; i < workers; i++ {
+			go func() {
+				defer wg.Done()
+
+				for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+					if !sendCtx(ctx, out.ch, call(res.Get())) {
+						return
+					}
+				}
+			}()
+		}
+
+		go func() {
+			wg.Wait()
+			out.Close()
+		}()
+
+		return out.receiver()
+	}
+
+	type job struct {
+		v   T
+		res chan Result[U]
+	}
+
+	jobs := make(chan job)
+	// pending keeps the order in which the Results must be sent.
+	pending := make(chan chan Result[U], workers)
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		for res := r.RecvCtx(ctx); res.IsOk(); res = r.RecvCtx(ctx) {
+			j := job{
+				v:   res.Get(),
+				res: make(chan Result[U], 1),
+			}
+
+			if !sendCtx(ctx, pending, j.res) || !sendCtx(ctx, jobs, j) {
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for j := range jobs {
+				j.res <- call(j.v)
+			}
+		}()
+	}
+
+	go func() {
+		defer out.Close()
+
+		for res := range pending {
+			select {
+			case v := <-res:
+				if !sendCtx(ctx, out.ch, v) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out.receiver()
+}
@@ -0,0 +1,435 @@
+package gtl
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestChannelRecv(t *testing.T) {
+	ch := MakeChan[int](1)
+	sender, recv := ch.Split()
+
+	if recv.TryRecv().HasValue() {
+		t.Fatal("empty channel returned a value")
+	}
+
+	if err := sender.SendTimeout(1, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sender.SendTimeout(2, time.Millisecond); err != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sender.SendCtx(ctx, 2); err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	if v := recv.TryRecv(); v.Get() != 1 {
+		t.Fatalf("expected 1, got %d", v.Get())
+	}
+
+	if r := recv.RecvCtx(ctx); r.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", r.Error())
+	}
+
+	if r := recv.RecvTimeout(time.Millisecond); r.Error() != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", r.Error())
+	}
+
+	go sender.SendCtx(context.Background(), 3)
+
+	if r := recv.RecvCtx(context.Background()); r.Get() != 3 {
+		t.Fatalf("expected 3, got %v %v", r.Get(), r.Error())
+	}
+
+	ch.Close()
+
+	if r := recv.RecvTimeout(time.Second); r.Error() != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", r.Error())
+	}
+
+	if err := sender.SendCtx(context.Background(), 4); err != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestChannelClose(t *testing.T) {
+	ch := MakeChan[int](1)
+	sender, recv := ch.Split()
+
+	if ch.IsClosed() || sender.IsClosed() || recv.IsClosed() {
+		t.Fatal("new channel is closed")
+	}
+
+	if err := sender.Send(1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sender.Send(2); err != ErrFull {
+		t.Fatalf("expected ErrFull, got %v", err)
+	}
+
+	blocked := make(chan error)
+	go func() {
+		blocked <- sender.SendCtx(context.Background(), 2)
+	}()
+
+	sender.Close()
+	ch.Close()
+
+	select {
+	case <-recv.Done():
+	default:
+		t.Fatal("Done not closed")
+	}
+
+	if !ch.IsClosed() || !recv.IsClosed() {
+		t.Fatal("close not shared between the halves")
+	}
+
+	if err := <-blocked; err != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+
+	if err := sender.Send(3); err != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+
+	if v := recv.Next(); v.Get() != 1 {
+		t.Fatalf("expected the buffered value, got %v", v.Get())
+	}
+
+	if recv.Next().HasValue() {
+		t.Fatal("closed channel returned a value")
+	}
+}
+
+func TestUnboundedChan(t *testing.T) {
+	sender, recv := MakeUnboundedChan[int]()
+
+	for i := 0; i < 1000; i++ {
+		if err := sender.Send(i); err != nil {
+			t.Fatalf("send %d failed: %v", i, err)
+		}
+	}
+
+	if n := recv.Len(); n != 1000 {
+		t.Fatalf("expected 1000 queued values, got %d", n)
+	}
+
+	if r := recv.RecvTimeout(time.Second); r.Get() != 0 {
+		t.Fatalf("expected 0, got %v %v", r.Get(), r.Error())
+	}
+
+	sender.Close()
+
+	if err := sender.Send(1000); err != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+
+	if err := sender.SendCtx(context.Background(), 1000); err != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+
+	i := 1
+	recv.Range(func(v int) {
+		if v != i {
+			t.Fatalf("expected %d, got %d", i, v)
+		}
+
+		i++
+	})
+
+	if i != 1000 || recv.Len() != 0 {
+		t.Fatalf("not all the values were drained: %d", i)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	chs := []Channel[int]{MakeChan[int](10), MakeChan[int](10)}
+
+	var receivers []Receiver[int]
+	for i := range chs {
+		sender, recv := chs[i].Split()
+		for j := 0; j < 10; j++ {
+			sender.Send(j)
+		}
+
+		chs[i].Close()
+		receivers = append(receivers, recv)
+	}
+
+	sum := Fold(Merge(context.Background(), receivers...).Iter(), 0, func(acc, v int) int {
+		return acc + v
+	})
+	if sum != 90 {
+		t.Fatalf("expected 90, got %d", sum)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	for _, mode := range []FanOutMode{FanOutRoundRobin, FanOutLoadBalance} {
+		ch := MakeChan[int](0)
+		sender, recv := ch.Split()
+
+		go func() {
+			for i := 0; i < 30; i++ {
+				sender.SendCtx(context.Background(), i)
+			}
+
+			ch.Close()
+		}()
+
+		counts := make(chan int)
+		for _, r := range FanOut(context.Background(), recv, 3, mode) {
+			go func(r Receiver[int]) {
+				counts <- Count(r.Iter())
+			}(r)
+		}
+
+		total := 0
+		for i := 0; i < 3; i++ {
+			n := <-counts
+			if mode == FanOutRoundRobin && n != 10 {
+				t.Fatalf("expected 10 values per receiver, got %d", n)
+			}
+
+			total += n
+		}
+
+		if total != 30 {
+			t.Fatalf("expected 30 values, got %d", total)
+		}
+	}
+}
+
+func TestFanCancel(t *testing.T) {
+	ch := MakeChan[int](1)
+	sender, recv := ch.Split()
+	defer ch.Close()
+
+	// nobody reads the outputs, so the forwarders block sending this value
+	sender.Send(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	outs := []Receiver[int]{Merge(ctx, recv)}
+	outs = append(outs, FanOut(ctx, recv, 2, FanOutRoundRobin)...)
+	outs = append(outs, FanOut(ctx, recv, 2, FanOutLoadBalance)...)
+
+	cancel()
+
+	for i, out := range outs {
+		select {
+		case <-out.Done():
+		case <-time.After(time.Second):
+			t.Fatalf("output %d was not closed after cancelling the context", i)
+		}
+	}
+}
+
+func TestBroadcast(t *testing.T) {
+	ch := MakeChan[int](0)
+	sender, recv := ch.Split()
+
+	b := Broadcast(recv, 2, OverflowDropOldest)
+	fast := b.Subscribe()
+	slow := b.Subscribe()
+	gone := b.Subscribe()
+
+	if !b.Unsubscribe(gone) || b.Unsubscribe(gone) {
+		t.Fatal("unexpected unsubscribe result")
+	}
+
+	if _, ok := <-gone.Get(); ok {
+		t.Fatal("unsubscribed receiver is not closed")
+	}
+
+	for i := 0; i < 5; i++ {
+		sender.SendCtx(context.Background(), i)
+
+		if r := fast.RecvTimeout(time.Second); r.Get() != i {
+			t.Fatalf("expected %d, got %v %v", i, r.Get(), r.Error())
+		}
+	}
+
+	ch.Close()
+
+	// the slow subscriber only keeps the last 2 values
+	expectVec(t, Collect(slow.Iter()), 3, 4)
+
+	if _, ok := <-fast.Get(); ok {
+		t.Fatal("subscriber is not closed")
+	}
+
+	if _, ok := <-b.Subscribe().Get(); ok {
+		t.Fatal("subscribing to a closed broadcaster should return a closed receiver")
+	}
+}
+
+func TestBroadcastUnsubscribeBlocked(t *testing.T) {
+	ch := MakeChan[int](0)
+	sender, recv := ch.Split()
+	defer ch.Close()
+
+	b := Broadcast(recv, 0, OverflowBlock)
+	slow := b.Subscribe()
+	fast := b.Subscribe()
+
+	// the delivery blocks on the slow subscriber, which never receives
+	sender.SendCtx(context.Background(), 1)
+
+	done := make(chan bool)
+	go func() {
+		done <- b.Unsubscribe(slow)
+	}()
+
+	select {
+	case ok := <-done:
+		if !ok {
+			t.Fatal("blocked subscriber was not unsubscribed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("unsubscribing a blocked subscriber deadlocked")
+	}
+
+	if b.Len() != 1 {
+		t.Fatalf("expected 1 subscriber, got %d", b.Len())
+	}
+
+	if r := fast.RecvTimeout(time.Second); r.Get() != 1 {
+		t.Fatalf("expected 1, got %v %v", r.Get(), r.Error())
+	}
+}
+
+func sendAll(values ...int) Receiver[int] {
+	ch := MakeChan[int](len(values))
+	sender, recv := ch.Split()
+
+	for _, v := range values {
+		sender.Send(v)
+	}
+
+	ch.Close()
+
+	return recv
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+
+	errOdd := errors.New("odd")
+
+	even := FilterChan(ctx, sendAll(1, 2, 3, 4, 5, 6, 7), func(n int) bool {
+		if n == 7 {
+			panic("seven")
+		}
+
+		return n%2 == 0
+	})
+	results := MapChan(ctx, even, func(r Result[int]) (string, error) {
+		n, err := r.Both()
+		if err != nil {
+			return "", err
+		}
+
+		if n == 4 {
+			return "", errOdd
+		}
+
+		return strconv.Itoa(n), nil
+	})
+
+	values, errs := PartitionResultsIter(results.Iter())
+	expectStrings(t, values, "2", "6")
+
+	var perr *PanicError
+	if len(errs) != 2 || errs[0] != errOdd || !errors.As(errs[1], &perr) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+
+	batches := Collect(Batch(ctx, sendAll(1, 2, 3, 4, 5), 2, time.Hour).Iter())
+	if batches.Len() != 3 || len(batches[2]) != 1 || batches[2][0] != 5 {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	ch := MakeChan[int](0)
+	sender, recv := ch.Split()
+
+	batches := Batch(context.Background(), recv, 10, 10*time.Millisecond)
+
+	sender.SendCtx(context.Background(), 1)
+
+	r := batches.RecvTimeout(time.Second)
+	if len(r.Get()) != 1 || r.Get()[0] != 1 {
+		t.Fatalf("unexpected batch: %v %v", r.Get(), r.Error())
+	}
+
+	ch.Close()
+
+	if r := batches.RecvTimeout(time.Second); r.Error() != ErrClosed {
+		t.Fatalf("expected ErrClosed, got %v", r.Error())
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	ctx := context.Background()
+
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = i
+	}
+
+	square := func(n int) (int, error) {
+		if n == 50 {
+			panic("50")
+		}
+
+		time.Sleep(time.Duration(n%3) * time.Millisecond)
+
+		return n * n, nil
+	}
+
+	results := Collect(ParallelMap(ctx, sendAll(values...), 8, true, square).Iter())
+	if results.Len() != 100 {
+		t.Fatalf("expected 100 results, got %d", results.Len())
+	}
+
+	for i, r := range results {
+		if i == 50 {
+			var perr *PanicError
+			if !r.AsErr(&perr) {
+				t.Fatalf("expected a panic error, got %v", r.Error())
+			}
+
+			continue
+		}
+
+		if r.Get() != i*i {
+			t.Fatalf("expected %d, got %d", i*i, r.Get())
+		}
+	}
+
+	unordered := ParallelMap(ctx, sendAll(values...), 8, false, square)
+	if n := Count(unordered.Iter()); n != 100 {
+		t.Fatalf("expected 100 results, got %d", n)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	canceled := ParallelMap(ctx, sendAll(values...), 4, true, square)
+	canceled.Next()
+	cancel()
+
+	for canceled.Next().HasValue() {
+	}
+}
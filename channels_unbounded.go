@@ -0,0 +1,107 @@
+package gtl
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// unbounded buffers the values sent to an unbounded channel in a Queue,
+// forwarding them to the output channel from a dedicated goroutine.
+type unbounded[T any] struct {
+	mu     sync.Mutex
+	q      Queue[T]
+	closed bool
+	// n counts the queued values, including the one being forwarded.
+	n      atomic.Int64
+	notify chan struct{}
+	out    chan T
+}
+
+// MakeUnboundedChan returns a Sender and a Receiver connected by an unbounded buffer.
+//
+// Sending never blocks nor drops values: the values that the receiver hasn't consumed yet
+// are kept in a growing Queue. Closing the Sender stops accepting values, and the Receiver
+// is closed once all the queued values have been received.
+//
+// The values are forwarded by a goroutine that exits once the Receiver is closed,
+// so the Receiver must be drained after closing the Sender.
+func MakeUnboundedChan[T any]() (Sender[T], Receiver[T]) {
+	ub := &unbounded[T]{
+		notify: make(chan struct{}, 1),
+		out:    make(chan T),
+	}
+
+	go ub.forward()
+
+	st := newChanState()
+
+	sender := Sender[T]{
+		st: st,
+		ub: ub,
+	}
+	recv := Receiver[T]{
+		ch: ub.out,
+		st: st,
+		ub: ub,
+	}
+
+	return sender, recv
+}
+
+func (ub *unbounded[T]) push(v T) bool {
+	ub.mu.Lock()
+	if ub.closed {
+		ub.mu.Unlock()
+		return false
+	}
+
+	ub.q.PushBack(v)
+	ub.n.Add(1)
+	ub.mu.Unlock()
+
+	ub.wake()
+
+	return true
+}
+
+func (ub *unbounded[T]) close() {
+	ub.mu.Lock()
+	ub.closed = true
+	ub.mu.Unlock()
+
+	ub.wake()
+}
+
+func (ub *unbounded[T]) wake() {
+	select {
+	case ub.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (ub *unbounded[T]) len() int {
+	return int(ub.n.Load())
+}
+
+func (ub *unbounded[T]) forward() {
+	defer close(ub.out)
+
+	for {
+		ub.mu.Lock()
+		v, closed := ub.q.Pop(), ub.closed
+		ub.mu.Unlock()
+
+		if !v.HasValue() {
+			if closed {
+				return
+			}
+
+			<-ub.notify
+
+			continue
+		}
+
+		ub.out <- v.Get()
+		ub.n.Add(-1)
+	}
+}
@@ -0,0 +1,50 @@
+package gtl
+
+import "golang.org/x/exp/constraints"
+
+// Equal consumes `a` and `b` and returns whether both yield the same values in the same order.
+//
+// It can be used to compare any gtl container, e.g: Equal(vc.Iter(), lst.Iter()).
+func Equal[T comparable](a, b Iterator[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool {
+		return x == y
+	})
+}
+
+// EqualFunc is like Equal but uses `eq` to compare the values.
+func EqualFunc[T, U any](a Iterator[T], b Iterator[U], eq func(T, U) bool) bool {
+	for {
+		okA, okB := a.Next(), b.Next()
+		if !okA || !okB {
+			return okA == okB
+		}
+
+		if !eq(a.Get(), b.Get()) {
+			return false
+		}
+	}
+}
+
+// Compare compares the values yielded by `a` and `b` lexicographically.
+//
+// Returns -1 if `a` is less than `b`, 1 if `a` is greater than `b` and 0 if both are equal.
+// If one is a prefix of the other, the shorter one is the lesser.
+func Compare[T constraints.Ordered](a, b Iterator[T]) int {
+	for {
+		okA, okB := a.Next(), b.Next()
+		switch {
+		case !okA && !okB:
+			return 0
+		case !okA:
+			return -1
+		case !okB:
+			return 1
+		}
+
+		if x, y := a.Get(), b.Get(); x < y {
+			return -1
+		} else if x > y {
+			return 1
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package gtl
+
+import (
+	"testing"
+)
+
+func TestClone(t *testing.T) {
+	vc := NewVec(1, 2, 3)
+
+	vc2 := vc.Clone()
+	vc2[0] = 10
+
+	if vc[0] != 1 || vc2[1] != 2 {
+		t.Fatal("clone shares the backing array")
+	}
+
+	var (
+		lst List[int]
+		q   Queue[int]
+	)
+
+	for i := 0; i < 3; i++ {
+		lst.Add(i)
+		q.PushBack(i)
+	}
+
+	lst2 := lst.Clone()
+	lst2.PopFront()
+
+	expected := NewVec(2, 1, 0)
+	if !Equal(lst.Iter(), expected.Iter()) || Count(lst2.Iter()) != 2 {
+		t.Fatal("unexpected list clone")
+	}
+
+	q2 := q.Clone()
+	q2.Pop()
+
+	expected = NewVec(0, 1, 2)
+	if !Equal(q.Iter(), expected.Iter()) || q2.Front().Get() != 1 {
+		t.Fatal("unexpected queue clone")
+	}
+
+	var tree Tree[string, int]
+	tree.Set(1, "a", "b")
+	tree.Set(2, "a", "c")
+
+	tree2 := tree.Clone()
+	tree2.Set(3, "a", "b")
+	tree2.Del("a", "c")
+
+	if tree.Fetch("a", "b").Get() != 1 || !tree.Fetch("a", "c").HasValue() {
+		t.Fatal("modifying the clone modified the tree")
+	}
+
+	if tree2.Fetch("a", "b").Get() != 3 || tree2.Fetch("a", "c").HasValue() {
+		t.Fatal("unexpected tree clone")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		a, b     Vec[int]
+		expected int
+	}{
+		{NewVec(1, 2, 3), NewVec(1, 2, 3), 0},
+		{NewVec(1, 2), NewVec(1, 2, 3), -1},
+		{NewVec(1, 3), NewVec(1, 2, 3), 1},
+		{NewVec[int](), NewVec[int](), 0},
+	}
+
+	for _, c := range cases {
+		if r := Compare(c.a.Iter(), c.b.Iter()); r != c.expected {
+			t.Fatalf("compare %v %v: expected %d, got %d", c.a, c.b, c.expected, r)
+		}
+	}
+
+	opts := NewVec(OptionalFrom(1), Optional[int]{})
+	opts2 := opts.Clone()
+
+	if !Equal(opts.Iter(), opts2.Iter()) {
+		t.Fatal("optionals should be equal")
+	}
+
+	nums, strs := NewVec(1, 2), NewVec("1")
+	if EqualFunc(nums.Iter(), strs.Iter(), func(n int, s string) bool {
+		return true
+	}) {
+		t.Fatal("vectors of different length should not be equal")
+	}
+}
@@ -78,8 +78,7 @@ func (c *Conn) writeLoop(recv gtl.Receiver[string]) {
 func (c *Conn) Write(data string) (int, error) {
 	n := len(data)
 
-	ok := c.sender.Send(data)
-	if !ok {
+	if err := c.sender.Send(data); err != nil {
 		return -1, io.EOF
 	}
 
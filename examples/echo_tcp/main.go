@@ -12,9 +12,13 @@ import (
 )
 
 func main() {
-	gtl.NewResult(
+	ln := gtl.MakeResult(
 		net.Listen("tcp", ":42421"),
 	).Expect("error listening")
+
+	if err := serve(ln); err != nil {
+		log.Fatalln(err)
+	}
 }
 
 func serve(ln net.Listener) error {
@@ -25,7 +29,7 @@ func serve(ln net.Listener) error {
 	go s.handleSignals()
 
 	for {
-		c := gtl.NewResult(
+		c := gtl.MakeResult(
 			ln.Accept(),
 		).ElseE(func(err error) error {
 			if strings.Contains(err.Error(), "use of closed") {
@@ -73,7 +77,7 @@ func (s *Server) handleSignals() {
 }
 
 func (s *Server) handleConn(c net.Conn) {
-	b := gtl.NewBytes(128, 128)
+	var buf gtl.BytesBuffer
 
 	defer func() {
 		it := s.conns.Search(func(nc net.Conn) bool {
@@ -88,18 +92,22 @@ func (s *Server) handleConn(c net.Conn) {
 	}()
 
 	for {
-		n, err := b.ReadFrom(c)
+		_, err := buf.Fill(c)
 		if err != nil {
 			break
 		}
 
-		i := b.Index('\n')
+		for i := buf.Bytes().Index('\n'); i != -1; i = buf.Bytes().Index('\n') {
+			line := buf.Next(i + 1)
 
-		fmt.Printf("Recv: %s\n", b.Slice(0, i))
+			fmt.Printf("Recv: %s", line)
 
-		_, err = b.LimitWriteTo(c, int(n))
-		if err != nil {
-			break
+			_, err = line.WriteTo(c)
+			if err != nil {
+				return
+			}
 		}
+
+		buf.Compact()
 	}
 }
@@ -0,0 +1,365 @@
+package gtl
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrNoFutures is returned by the combinators that can't resolve without any future.
+var ErrNoFutures = errors.New("gtl: no futures")
+
+// Future represents a Result that will be available in the future.
+type Future[T any] struct {
+	done chan struct{}
+	res  Result[T]
+
+	mu        sync.Mutex
+	completed bool
+	// callbacks are called with the Result once the Future is completed.
+	callbacks []func(Result[T])
+}
+
+func newFuture[T any]() *Future[T] {
+	return &Future[T]{
+		done: make(chan struct{}),
+	}
+}
+
+// complete sets the Result of the Future and calls the registered callbacks. Only the first call has effect.
+func (f *Future[T]) complete(res Result[T]) bool {
+	f.mu.Lock()
+	if f.completed {
+		f.mu.Unlock()
+		return false
+	}
+
+	f.completed = true
+	f.res = res
+	close(f.done)
+
+	callbacks := f.callbacks
+	f.callbacks = nil
+	f.mu.Unlock()
+
+	for _, fn := range callbacks {
+		fn(res)
+	}
+
+	return true
+}
+
+// onComplete registers `fn` to be called with the Result once the Future is completed.
+//
+// If the Future is already completed, `fn` is called immediately.
+func (f *Future[T]) onComplete(fn func(Result[T])) {
+	f.mu.Lock()
+	if !f.completed {
+		f.callbacks = append(f.callbacks, fn)
+		f.mu.Unlock()
+
+		return
+	}
+	f.mu.Unlock()
+
+	fn(f.res)
+}
+
+// Done returns a channel that is closed when the Result is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits until the Result is available or `ctx` is done.
+//
+// If `ctx` is done first, the returned Result holds the context's error.
+func (f *Future[T]) Await(ctx context.Context) (r Result[T]) {
+	select {
+	case <-f.done:
+		return f.res
+	case <-ctx.Done():
+		return r.Err(ctx.Err())
+	}
+}
+
+// Poll returns the Result if it is available, without waiting.
+func (f *Future[T]) Poll() (opt Optional[Result[T]]) {
+	select {
+	case <-f.done:
+		opt.Set(f.res)
+	default:
+	}
+
+	return opt
+}
+
+// Async calls `fn` in a new goroutine and returns a Future resolving to its Result.
+//
+// A panic in `fn` is recovered and reported as a *PanicError.
+func Async[T any](fn func() (T, error)) *Future[T] {
+	f := newFuture[T]()
+
+	go func() {
+		f.complete(Try(fn))
+	}()
+
+	return f
+}
+
+// Then returns a Future that resolves to the same Result as `f`, after calling `fn` if `f` succeeded.
+//
+// `fn` is called from the goroutine completing `f`. A panic in `fn` is recovered and reported as a *PanicError.
+func (f *Future[T]) Then(fn func(T)) *Future[T] {
+	nf := newFuture[T]()
+
+	f.onComplete(func(res Result[T]) {
+		nf.complete(Try(func() (T, error) {
+			return res.Then(fn).Both()
+		}))
+	})
+
+	return nf
+}
+
+// Else returns a Future that resolves to the same Result as `f`, after calling `fn` if `f` failed.
+//
+// `fn` is called from the goroutine completing `f`. A panic in `fn` is recovered and reported as a *PanicError.
+func (f *Future[T]) Else(fn func(error)) *Future[T] {
+	nf := newFuture[T]()
+
+	f.onComplete(func(res Result[T]) {
+		nf.complete(Try(func() (T, error) {
+			return res.Else(fn).Both()
+		}))
+	})
+
+	return nf
+}
+
+// WithTimeout returns a Future that resolves to the Result of `f`,
+// or to ErrTimeout if `f` doesn't resolve within `timeout`.
+func (f *Future[T]) WithTimeout(timeout time.Duration) *Future[T] {
+	nf := newFuture[T]()
+
+	go func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+
+		select {
+		case <-f.done:
+			nf.complete(f.res)
+		case <-timer.C:
+			var r Result[T]
+			nf.complete(r.Err(ErrTimeout))
+		}
+	}()
+
+	return nf
+}
+
+// MapFuture returns a Future that resolves to the value of `f` passed through `fn`.
+//
+// If `f` fails, `fn` is not called and the returned Future resolves to the same error.
+func MapFuture[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	return ThenFuture(f, func(v T) *Future[U] {
+		nf := newFuture[U]()
+		nf.complete(Try(func() (U, error) {
+			return fn(v)
+		}))
+
+		return nf
+	})
+}
+
+// ThenFuture returns a Future that resolves to the Future returned by `fn`,
+// which is called with the value of `f`.
+//
+// If `f` fails, `fn` is not called and the returned Future resolves to the same error.
+// `fn` is called from the goroutine completing `f`.
+// A panic in `fn`, or `fn` returning a nil Future, is reported as a *PanicError.
+func ThenFuture[T, U any](f *Future[T], fn func(T) *Future[U]) *Future[U] {
+	nf := newFuture[U]()
+
+	f.onComplete(func(res Result[T]) {
+		var r Result[U]
+
+		if res.err != nil {
+			nf.complete(r.Err(res.err))
+			return
+		}
+
+		next := Try(func() (*Future[U], error) {
+			next := fn(res.value)
+			if next == nil {
+				panic("gtl: ThenFuture callback returned a nil Future")
+			}
+
+			return next, nil
+		})
+		if next.err != nil {
+			nf.complete(r.Err(next.err))
+			return
+		}
+
+		next.value.onComplete(func(res Result[U]) {
+			nf.complete(res)
+		})
+	})
+
+	return nf
+}
+
+// AllFutures returns a Future that resolves when all the `futures` are resolved.
+//
+// The returned Future holds the values in the same order as `futures`.
+// If any of the `futures` fails, all the errors are joined like JoinResults does.
+func AllFutures[T any](futures ...*Future[T]) *Future[[]T] {
+	nf := newFuture[[]T]()
+
+	results := make([]Result[T], len(futures))
+	if len(futures) == 0 {
+		nf.complete(JoinResults(results))
+		return nf
+	}
+
+	var pending atomic.Int64
+
+	pending.Store(int64(len(futures)))
+
+	for i, f := range futures {
+		i := i
+
+		f.onComplete(func(res Result[T]) {
+			results[i] = res
+
+			if pending.Add(-1) == 0 {
+				nf.complete(JoinResults(results))
+			}
+		})
+	}
+
+	return nf
+}
+
+// AnyFutures returns a Future that resolves to the first successful Result of `futures`.
+//
+// If all the `futures` fail, the returned Future holds all the errors joined.
+// If no `futures` are given, the returned Future holds ErrNoFutures.
+func AnyFutures[T any](futures ...*Future[T]) *Future[T] {
+	nf := newFuture[T]()
+
+	if len(futures) == 0 {
+		var r Result[T]
+		nf.complete(r.Err(ErrNoFutures))
+
+		return nf
+	}
+
+	results := make(chan Result[T], len(futures))
+	for _, f := range futures {
+		go forwardResult(f, nf, results)
+	}
+
+	go func() {
+		errs := make([]error, 0, len(futures))
+
+		for range futures {
+			select {
+			case r := <-results:
+				if r.IsOk() {
+					nf.complete(r)
+					return
+				}
+
+				errs = append(errs, r.err)
+			case <-nf.done:
+				return
+			}
+		}
+
+		var r Result[T]
+		nf.complete(r.Err(errors.Join(errs...)))
+	}()
+
+	return nf
+}
+
+// RaceFutures returns a Future that resolves to the Result of the first resolved future of `futures`.
+//
+// If no `futures` are given, the returned Future holds ErrNoFutures.
+func RaceFutures[T any](futures ...*Future[T]) *Future[T] {
+	nf := newFuture[T]()
+
+	if len(futures) == 0 {
+		var r Result[T]
+		nf.complete(r.Err(ErrNoFutures))
+
+		return nf
+	}
+
+	results := make(chan Result[T], len(futures))
+	for _, f := range futures {
+		go forwardResult(f, nf, results)
+	}
+
+	go func() {
+		select {
+		case r := <-results:
+			nf.complete(r)
+		case <-nf.done:
+		}
+	}()
+
+	return nf
+}
+
+// forwardResult sends the Result of `f` to `results` unless `until` is resolved before.
+func forwardResult[T, U any](f *Future[T], until *Future[U], results chan<- Result[T]) {
+	select {
+	case <-f.done:
+		results <- f.res
+	case <-until.done:
+	}
+}
+
+// Promise completes a Future exactly once.
+type Promise[T any] struct {
+	f *Future[T]
+}
+
+// NewPromise returns a Promise with a pending Future.
+func NewPromise[T any]() Promise[T] {
+	return Promise[T]{
+		f: newFuture[T](),
+	}
+}
+
+// Future returns the Future completed by the Promise.
+func (p Promise[T]) Future() *Future[T] {
+	return p.f
+}
+
+// Complete resolves the Future to `r`.
+//
+// Returns false if the Future has already been completed, in which case `r` is discarded.
+func (p Promise[T]) Complete(r Result[T]) bool {
+	return p.f.complete(r)
+}
+
+// Resolve resolves the Future to the value `v`.
+func (p Promise[T]) Resolve(v T) bool {
+	var r Result[T]
+	return p.Complete(r.Ok(v))
+}
+
+// Reject resolves the Future to the error `err`. Reject panics if `err` is nil.
+func (p Promise[T]) Reject(err error) bool {
+	if err == nil {
+		panic("gtl: Promise rejected with a nil error")
+	}
+
+	var r Result[T]
+	return p.Complete(r.Err(err))
+}
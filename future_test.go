@@ -0,0 +1,193 @@
+package gtl
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPromise(t *testing.T) {
+	p := NewPromise[int]()
+
+	if p.Future().Poll().HasValue() {
+		t.Fatal("future resolved before completing the promise")
+	}
+
+	if !p.Resolve(1) || p.Resolve(2) || p.Reject(errors.New("late")) {
+		t.Fatal("a promise must complete exactly once")
+	}
+
+	if r := p.Future().Await(context.Background()); r.Get() != 1 {
+		t.Fatalf("expected 1, got %v %v", r.Get(), r.Error())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if r := NewPromise[int]().Future().Await(ctx); r.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", r.Error())
+	}
+}
+
+func TestFutureChain(t *testing.T) {
+	ctx := context.Background()
+
+	called := false
+
+	f := Async(func() (int, error) {
+		return 20, nil
+	}).Then(func(int) {
+		called = true
+	})
+
+	r := MapFuture(f, func(n int) (string, error) {
+		return strconv.Itoa(n), nil
+	}).Await(ctx)
+	if r.Get() != "20" || !called {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	errFail := errors.New("fail")
+
+	failed := Async(func() (int, error) {
+		return 0, errFail
+	})
+
+	nested := ThenFuture(failed, func(n int) *Future[int] {
+		t.Fatal("ThenFuture called on a failed future")
+		return nil
+	})
+	if r := nested.Await(ctx); r.Error() != errFail {
+		t.Fatalf("expected errFail, got %v", r.Error())
+	}
+
+	slow := NewPromise[int]()
+	if r := slow.Future().WithTimeout(time.Millisecond).Await(ctx); r.Error() != ErrTimeout {
+		t.Fatalf("expected ErrTimeout, got %v", r.Error())
+	}
+}
+
+func TestFuturePanic(t *testing.T) {
+	ctx := context.Background()
+
+	ok := Async(func() (int, error) {
+		return 1, nil
+	})
+
+	var perr *PanicError
+
+	then := ok.Then(func(int) {
+		panic("then")
+	})
+	if r := then.Await(ctx); !r.AsErr(&perr) || perr.Value != "then" {
+		t.Fatalf("expected a PanicError, got %v", r.Error())
+	}
+
+	elseF := Async(func() (int, error) {
+		return 0, errors.New("fail")
+	}).Else(func(error) {
+		panic("else")
+	})
+	if r := elseF.Await(ctx); !r.AsErr(&perr) || perr.Value != "else" {
+		t.Fatalf("expected a PanicError, got %v", r.Error())
+	}
+
+	nilFuture := ThenFuture(ok, func(int) *Future[int] {
+		return nil
+	})
+	if r := nilFuture.Await(ctx); !r.AsErr(&perr) {
+		t.Fatalf("expected a PanicError, got %v", r.Error())
+	}
+}
+
+func TestFuturePendingChains(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	p := NewPromise[int]()
+	f := p.Future()
+
+	// chaining a Future that is never completed must not start any goroutine
+	for i := 0; i < 100; i++ {
+		f.Then(func(int) {})
+		f.Else(func(error) {})
+		MapFuture(f, func(n int) (int, error) {
+			return n, nil
+		})
+		AllFutures(f, f)
+	}
+
+	if n := runtime.NumGoroutine(); n > before+10 {
+		t.Fatalf("pending chains started %d goroutines", n-before)
+	}
+
+	chained := MapFuture(f.Then(func(int) {}), func(n int) (string, error) {
+		return strconv.Itoa(n), nil
+	})
+	all := AllFutures(f, Async(func() (int, error) { return 2, nil }))
+
+	p.Resolve(1)
+
+	if r := chained.Await(context.Background()); r.Get() != "1" {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	if r := all.Await(context.Background()); len(r.Get()) != 2 || r.Get()[1] != 2 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+}
+
+func TestFutureCombinators(t *testing.T) {
+	ctx := context.Background()
+
+	errA := errors.New("a")
+
+	ps := []Promise[int]{NewPromise[int](), NewPromise[int](), NewPromise[int]()}
+	futures := []*Future[int]{ps[0].Future(), ps[1].Future(), ps[2].Future()}
+
+	all := AllFutures(futures...)
+	anyF := AnyFutures(futures...)
+	race := RaceFutures(futures...)
+
+	ps[1].Reject(errA)
+
+	if r := race.Await(ctx); r.Error() != errA {
+		t.Fatalf("expected errA, got %v", r.Error())
+	}
+
+	ps[2].Resolve(2)
+
+	if r := anyF.Await(ctx); r.Get() != 2 {
+		t.Fatalf("expected 2, got %v %v", r.Get(), r.Error())
+	}
+
+	if all.Poll().HasValue() {
+		t.Fatal("AllFutures resolved before all the futures")
+	}
+
+	ps[0].Resolve(0)
+
+	if r := all.Await(ctx); !errors.Is(r.Error(), errA) {
+		t.Fatalf("expected errA, got %v", r.Error())
+	}
+
+	ok := AllFutures(Async(func() (int, error) { return 1, nil }), Async(func() (int, error) { return 2, nil }))
+	if r := ok.Await(ctx); len(r.Get()) != 2 || r.Get()[1] != 2 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	none := AnyFutures(Async(func() (int, error) { return 0, errA }))
+	if r := none.Await(ctx); !errors.Is(r.Error(), errA) {
+		t.Fatalf("expected errA, got %v", r.Error())
+	}
+
+	if r := AnyFutures[int]().Await(ctx); r.Error() != ErrNoFutures {
+		t.Fatalf("expected ErrNoFutures, got %v", r.Error())
+	}
+
+	if r := RaceFutures[int]().Await(ctx); r.Error() != ErrNoFutures {
+		t.Fatalf("expected ErrNoFutures, got %v", r.Error())
+	}
+}
@@ -1,5 +1,5 @@
 module github.com/dgrr/gtl
 
-go 1.18
+go 1.21
 
 require golang.org/x/exp v0.0.0-20220314205449-43aec2f8a4e7
@@ -12,6 +12,24 @@ type Iterator[T any] interface {
 	Ptr() *T
 }
 
+// BidirectionalIterator defines an Iterator that can also move backward.
+type BidirectionalIterator[T any] interface {
+	Iterator[T]
+	// Prev moves the iterator one step backward.
+	Prev() bool
+}
+
+// RandomAccessIterator defines an Iterator that can be moved to any position in constant time.
+type RandomAccessIterator[T any] interface {
+	BidirectionalIterator[T]
+	// Seek moves the iterator to the position `i`. Returns false if `i` is out of range.
+	Seek(i int) bool
+	// Index returns the position of the iterator.
+	Index() int
+	// Distance returns the number of steps needed to move the iterator to the position of `other`.
+	Distance(other RandomAccessIterator[T]) int
+}
+
 // Iter implements the Iterator[T] interface.
 //
 // The iterator might not be directly used by the user, but as a mean
@@ -42,7 +60,17 @@ func (it *Iter[T, T2]) Next() bool {
 	return it.v != nil
 }
 
+// Advance advances the iterator `n` steps.
+//
+// If the iterator doesn't define a way to advance, Next is called `n` times.
 func (it *Iter[T, T2]) Advance(n int) bool {
+	if it.advance == nil {
+		for i := 0; i < n && it.Next(); i++ {
+		}
+
+		return it.v != nil
+	}
+
 	it.v, it.index = it.advance(it.index, n)
 
 	return it.v != nil
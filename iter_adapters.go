@@ -0,0 +1,328 @@
+package gtl
+
+import "golang.org/x/exp/constraints"
+
+// iterFrom returns an Iterator that calls `next` to get the next value.
+//
+// `next` must return nil when there are no more values.
+func iterFrom[T any](next func() *T) *Iter[T, int] {
+	return &Iter[T, int]{
+		next: func(cnt int) (*T, int) {
+			return next(), cnt + 1
+		},
+	}
+}
+
+// Map returns an Iterator that passes every value of `it` to `fn`.
+func Map[T, U any](it Iterator[T], fn func(T) U) Iterator[U] {
+	return iterFrom(func() *U {
+		if !it.Next() {
+			return nil
+		}
+
+		v := fn(it.Get())
+
+		return &v
+	})
+}
+
+// FilterIter returns an Iterator that only yields the values of `it` for which `fn` returns true.
+func FilterIter[T any](it Iterator[T], fn func(T) bool) Iterator[T] {
+	return iterFrom(func() *T {
+		for it.Next() {
+			if fn(it.Get()) {
+				return it.Ptr()
+			}
+		}
+
+		return nil
+	})
+}
+
+// Take returns an Iterator that yields at most `n` values of `it`.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	return iterFrom(func() *T {
+		if n <= 0 || !it.Next() {
+			return nil
+		}
+
+		n--
+
+		return it.Ptr()
+	})
+}
+
+// Skip returns an Iterator that skips the first `n` values of `it`.
+func Skip[T any](it Iterator[T], n int) Iterator[T] {
+	return iterFrom(func() *T {
+		for ; n > 0; n-- {
+			if !it.Next() {
+				return nil
+			}
+		}
+
+		if !it.Next() {
+			return nil
+		}
+
+		return it.Ptr()
+	})
+}
+
+// TakeWhile returns an Iterator that yields the values of `it` until `fn` returns false.
+func TakeWhile[T any](it Iterator[T], fn func(T) bool) Iterator[T] {
+	done := false
+
+	return iterFrom(func() *T {
+		if done || !it.Next() || !fn(it.Get()) {
+			done = true
+			return nil
+		}
+
+		return it.Ptr()
+	})
+}
+
+// Chain returns an Iterator that yields the values of every iterator in `its`, one after another.
+func Chain[T any](its ...Iterator[T]) Iterator[T] {
+	return iterFrom(func() *T {
+		for len(its) != 0 {
+			if its[0].Next() {
+				return its[0].Ptr()
+			}
+
+			its = its[1:]
+		}
+
+		return nil
+	})
+}
+
+// Zip returns an Iterator that yields a Pair with the values of `a` and `b`.
+//
+// The iterator stops when any of both iterators is exhausted.
+func Zip[T, U any](a Iterator[T], b Iterator[U]) Iterator[Pair[T, U]] {
+	return iterFrom(func() *Pair[T, U] {
+		if !a.Next() || !b.Next() {
+			return nil
+		}
+
+		p := MakePair(a.Get(), b.Get())
+
+		return &p
+	})
+}
+
+// Enumerate returns an Iterator that yields a Pair with the position and the value of `it`.
+func Enumerate[T any](it Iterator[T]) Iterator[Pair[int, T]] {
+	i := 0
+
+	return iterFrom(func() *Pair[int, T] {
+		if !it.Next() {
+			return nil
+		}
+
+		p := MakePair(i, it.Get())
+		i++
+
+		return &p
+	})
+}
+
+// Flatten returns an Iterator that yields the values of every iterator yielded by `it`.
+func Flatten[T any](it Iterator[Iterator[T]]) Iterator[T] {
+	var cur Iterator[T]
+
+	return iterFrom(func() *T {
+		for {
+			if cur != nil && cur.Next() {
+				return cur.Ptr()
+			}
+
+			if !it.Next() {
+				return nil
+			}
+
+			cur = it.Get()
+		}
+	})
+}
+
+// Chunk returns an Iterator that groups the values of `it` in vectors of `n` elements.
+//
+// The last vector might hold less than `n` elements.
+func Chunk[T any](it Iterator[T], n int) Iterator[Vec[T]] {
+	return iterFrom(func() *Vec[T] {
+		vc := NewVecSize[T](0, n)
+		for vc.Len() < n && it.Next() {
+			vc.Append(it.Get())
+		}
+
+		if vc.Len() == 0 {
+			return nil
+		}
+
+		return &vc
+	})
+}
+
+// Peekable is an Iterator that allows to look at the next value without advancing.
+type Peekable[T any] struct {
+	it     Iterator[T]
+	v      *T
+	next   *T
+	peeked bool
+}
+
+// MakePeekable returns a Peekable iterator over `it`.
+func MakePeekable[T any](it Iterator[T]) *Peekable[T] {
+	return &Peekable[T]{
+		it: it,
+	}
+}
+
+// Peek returns the next value without advancing the iterator.
+//
+// If there are no more values, an empty Optional is returned.
+func (p *Peekable[T]) Peek() (opt Optional[T]) {
+	if !p.peeked {
+		p.peeked = true
+		p.next = nil
+
+		if p.it.Next() {
+			p.next = p.it.Ptr()
+		}
+	}
+
+	if p.next != nil {
+		opt.Set(*p.next)
+	}
+
+	return opt
+}
+
+// Next advances the iterator.
+func (p *Peekable[T]) Next() bool {
+	if p.peeked {
+		p.peeked = false
+		p.v = p.next
+	} else if p.it.Next() {
+		p.v = p.it.Ptr()
+	} else {
+		p.v = nil
+	}
+
+	return p.v != nil
+}
+
+// Advance advances the iterator `n` steps.
+func (p *Peekable[T]) Advance(n int) bool {
+	for i := 0; i < n && p.Next(); i++ {
+	}
+
+	return p.v != nil
+}
+
+// Get returns the value held in the iterator.
+func (p *Peekable[T]) Get() T {
+	return *p.v
+}
+
+// Ptr returns a pointer to the value held in the iterator.
+func (p *Peekable[T]) Ptr() *T {
+	return p.v
+}
+
+// Collect consumes `it` and returns the values in a Vec.
+func Collect[T any](it Iterator[T]) Vec[T] {
+	vc := NewVec[T]()
+	for it.Next() {
+		vc.Append(it.Get())
+	}
+
+	return vc
+}
+
+// Fold consumes `it` passing the accumulated value and every value of `it` to `fn`.
+//
+// The returned value of `fn` is used as accumulated value in the next call.
+func Fold[T, U any](it Iterator[T], init U, fn func(U, T) U) U {
+	for it.Next() {
+		init = fn(init, it.Get())
+	}
+
+	return init
+}
+
+// Count consumes `it` and returns the number of values.
+func Count[T any](it Iterator[T]) (n int) {
+	for it.Next() {
+		n++
+	}
+
+	return n
+}
+
+// Any returns true if `fn` returns true for any of the values of `it`.
+//
+// Any stops consuming `it` at the first match.
+func Any[T any](it Iterator[T], fn func(T) bool) bool {
+	for it.Next() {
+		if fn(it.Get()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All returns true if `fn` returns true for all the values of `it`.
+//
+// All stops consuming `it` at the first mismatch.
+func All[T any](it Iterator[T], fn func(T) bool) bool {
+	for it.Next() {
+		if !fn(it.Get()) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Find returns the first value of `it` for which `fn` returns true.
+func Find[T any](it Iterator[T], fn func(T) bool) (opt Optional[T]) {
+	for it.Next() {
+		if fn(it.Get()) {
+			opt.Set(it.Get())
+			break
+		}
+	}
+
+	return opt
+}
+
+// MinIter consumes `it` and returns the minimum value.
+//
+// If `it` has no values, an empty Optional is returned.
+func MinIter[T constraints.Ordered](it Iterator[T]) (opt Optional[T]) {
+	for it.Next() {
+		if !opt.hasValue || it.Get() < opt.value {
+			opt.Set(it.Get())
+		}
+	}
+
+	return opt
+}
+
+// MaxIter consumes `it` and returns the maximum value.
+//
+// If `it` has no values, an empty Optional is returned.
+func MaxIter[T constraints.Ordered](it Iterator[T]) (opt Optional[T]) {
+	for it.Next() {
+		if !opt.hasValue || it.Get() > opt.value {
+			opt.Set(it.Get())
+		}
+	}
+
+	return opt
+}
@@ -0,0 +1,117 @@
+package gtl
+
+import (
+	"strconv"
+	"testing"
+)
+
+func expectVec[T comparable](t *testing.T, vc Vec[T], expected ...T) {
+	t.Helper()
+
+	if vc.Len() != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, vc)
+	}
+
+	for i := range expected {
+		if vc[i] != expected[i] {
+			t.Fatalf("expected %v, got %v", expected, vc)
+		}
+	}
+}
+
+func TestIterAdapters(t *testing.T) {
+	vc := NewVec(1, 2, 3, 4, 5, 6, 7, 8)
+
+	even := func(n int) bool {
+		return n%2 == 0
+	}
+
+	expectVec(t, Collect(Map(FilterIter(vc.Iter(), even), strconv.Itoa)), "2", "4", "6", "8")
+	expectVec(t, Collect(Take(Skip(vc.Iter(), 2), 3)), 3, 4, 5)
+	expectVec(t, Collect(Skip(vc.Iter(), 10)))
+	expectVec(t, Collect(TakeWhile(vc.Iter(), func(n int) bool {
+		return n < 4
+	})), 1, 2, 3)
+
+	other := NewVec(9, 10)
+	expectVec(t, Collect(Chain(vc.Iter(), other.Iter())), 1, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+
+	pairs := Collect(Zip(vc.Iter(), other.Iter()))
+	expectVec(t, pairs, MakePair(1, 9), MakePair(2, 10))
+
+	enum := Collect(Enumerate(other.Iter()))
+	expectVec(t, enum, MakePair(0, 9), MakePair(1, 10))
+
+	chunks := Collect(Chunk(vc.Iter(), 3))
+	if chunks.Len() != 3 {
+		t.Fatalf("expected 3 chunks, got %d", chunks.Len())
+	}
+
+	expectVec(t, chunks[2], 7, 8)
+	expectVec(t, Collect(Flatten(Map(chunks.Iter(), func(c Vec[int]) Iterator[int] {
+		return c.Iter()
+	}))), vc...)
+}
+
+func TestIterPeekable(t *testing.T) {
+	vc := NewVec(1, 2)
+
+	it := MakePeekable(vc.Iter())
+	if it.Peek().Get() != 1 || it.Peek().Get() != 1 {
+		t.Fatal("peek advanced the iterator")
+	}
+
+	if !it.Next() || it.Get() != 1 {
+		t.Fatal("expected 1")
+	}
+
+	if !it.Next() || it.Get() != 2 {
+		t.Fatal("expected 2")
+	}
+
+	if it.Peek().HasValue() || it.Next() {
+		t.Fatal("iterator should be exhausted")
+	}
+}
+
+func TestIterTerminal(t *testing.T) {
+	vc := NewVec(3, 1, 4, 1, 5)
+
+	sum := Fold(vc.Iter(), 0, func(acc, n int) int {
+		return acc + n
+	})
+	if sum != 14 {
+		t.Fatalf("expected 14, got %d", sum)
+	}
+
+	if n := Count(vc.Iter()); n != 5 {
+		t.Fatalf("expected 5, got %d", n)
+	}
+
+	gt := func(n int) func(int) bool {
+		return func(v int) bool {
+			return v > n
+		}
+	}
+
+	if !Any(vc.Iter(), gt(4)) || Any(vc.Iter(), gt(5)) {
+		t.Fatal("unexpected Any result")
+	}
+
+	if !All(vc.Iter(), gt(0)) || All(vc.Iter(), gt(1)) {
+		t.Fatal("unexpected All result")
+	}
+
+	if v := Find(vc.Iter(), gt(3)); v.Get() != 4 {
+		t.Fatalf("expected 4, got %d", v.Get())
+	}
+
+	if MinIter(vc.Iter()).Get() != 1 || MaxIter(vc.Iter()).Get() != 5 {
+		t.Fatal("unexpected min or max")
+	}
+
+	var empty Vec[int]
+	if MinIter(empty.Iter()).HasValue() {
+		t.Fatal("min of an empty vector")
+	}
+}
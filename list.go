@@ -29,6 +29,19 @@ func (lst *List[T]) PopFront() (v T) {
 	return
 }
 
+// Clone returns a copy of the linked list keeping the order of the elements.
+func (lst *List[T]) Clone() (r List[T]) {
+	tail := &r.next
+	for e := lst.next; e != nil; e = e.next {
+		*tail = &listElement[T]{
+			v: e.v,
+		}
+		tail = &(*tail).next
+	}
+
+	return r
+}
+
 // Iter returns an iterator for the linked list.
 func (lst *List[T]) Iter() Iterator[T] {
 	iter := &Iter[T, *listElement[T]]{
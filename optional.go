@@ -125,3 +125,55 @@ func (opt *Optional[T]) Set(v T) {
 func (opt *Optional[T]) Reset() {
 	opt.hasValue = false
 }
+
+// Filter returns `opt` if it is holding a value and `fn` returns true for it.
+// Otherwise, an empty Optional is returned.
+func (opt Optional[T]) Filter(fn func(T) bool) Optional[T] {
+	if opt.hasValue && !fn(opt.value) {
+		opt.Reset()
+	}
+
+	return opt
+}
+
+// OkOr converts the Optional into a Result.
+//
+// If the Optional is holding a value, the Result holds the value. Otherwise, the Result holds `err`.
+func (opt Optional[T]) OkOr(err error) (r Result[T]) {
+	if opt.hasValue {
+		return r.Ok(opt.value)
+	}
+
+	return r.Err(err)
+}
+
+// MapOptional converts an Optional[T] into an Optional[U] by passing the holding value to `fn`.
+//
+// If `opt` is not holding any value, `fn` is not called and an empty Optional is returned.
+func MapOptional[T, U any](opt Optional[T], fn func(T) U) (r Optional[U]) {
+	if opt.hasValue {
+		r.Set(fn(opt.value))
+	}
+
+	return r
+}
+
+// FlatMapOptional is like MapOptional but `fn` returns an Optional itself.
+func FlatMapOptional[T, U any](opt Optional[T], fn func(T) Optional[U]) (r Optional[U]) {
+	if opt.hasValue {
+		return fn(opt.value)
+	}
+
+	return r
+}
+
+// ZipOptional combines two Optionals into an Optional holding a Pair.
+//
+// The returned Optional only holds a value if both `a` and `b` are holding a value.
+func ZipOptional[T, U any](a Optional[T], b Optional[U]) (r Optional[Pair[T, U]]) {
+	if a.hasValue && b.hasValue {
+		r.Set(MakePair(a.value, b.value))
+	}
+
+	return r
+}
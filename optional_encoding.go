@@ -0,0 +1,359 @@
+package gtl
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+var (
+	_ json.Marshaler   = Optional[int]{}
+	_ json.Unmarshaler = (*Optional[int])(nil)
+	_ sql.Scanner      = (*Optional[int])(nil)
+)
+
+var jsonNull = []byte("null")
+
+// IsZero returns true if the Optional is not holding a value.
+//
+// IsZero makes encoders supporting the `omitzero` option (like encoding/json)
+// skip empty Optionals.
+func (opt Optional[T]) IsZero() bool {
+	return !opt.hasValue
+}
+
+// MarshalJSON encodes the value held by the Optional.
+//
+// If the Optional is not holding any value, it is encoded as `null`.
+func (opt Optional[T]) MarshalJSON() ([]byte, error) {
+	if !opt.hasValue {
+		return jsonNull, nil
+	}
+
+	// opt is a copy, so the marshaler of *T can be used as well
+	return json.Marshal(&opt.value)
+}
+
+// UnmarshalJSON decodes `data` into the Optional.
+//
+// If `data` is `null`, the Optional is reset.
+func (opt *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		opt.Reset()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	opt.Set(v)
+
+	return nil
+}
+
+// MarshalText encodes the value held by the Optional if T implements encoding.TextMarshaler,
+// or if T is a string, a byte slice, a bool or a number.
+//
+// If the Optional is not holding any value, an empty text is returned.
+func (opt Optional[T]) MarshalText() ([]byte, error) {
+	if !opt.hasValue {
+		return []byte{}, nil
+	}
+
+	if m, ok := any(&opt.value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+
+	if text, ok := marshalText(reflect.ValueOf(&opt.value).Elem()); ok {
+		return text, nil
+	}
+
+	return nil, fmt.Errorf("gtl: Optional[%T] does not implement encoding.TextMarshaler", opt.value)
+}
+
+// UnmarshalText decodes `text` into the Optional if *T implements encoding.TextUnmarshaler,
+// or if T is a string, a byte slice, a bool or a number.
+//
+// An empty text resets the Optional.
+func (opt *Optional[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		opt.Reset()
+		return nil
+	}
+
+	var v T
+
+	if u, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if ok, err := unmarshalText(reflect.ValueOf(&v).Elem(), text); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("gtl: Optional[%T] does not implement encoding.TextUnmarshaler", v)
+	}
+
+	opt.Set(v)
+
+	return nil
+}
+
+// marshalText encodes `v` if it is a string, a byte slice, a bool or a number.
+func marshalText(v reflect.Value) ([]byte, bool) {
+	switch {
+	case isStringKind(v):
+		if v.Kind() == reflect.String {
+			return []byte(v.String()), true
+		}
+
+		return append([]byte{}, v.Bytes()...), true
+	case v.Kind() == reflect.Bool:
+		return strconv.AppendBool(nil, v.Bool()), true
+	case isIntKind(v.Kind()):
+		return strconv.AppendInt(nil, v.Int(), 10), true
+	case isUintKind(v.Kind()):
+		return strconv.AppendUint(nil, v.Uint(), 10), true
+	case isFloatKind(v.Kind()):
+		return strconv.AppendFloat(nil, v.Float(), 'g', -1, v.Type().Bits()), true
+	}
+
+	return nil, false
+}
+
+// unmarshalText decodes `text` into `v` if it is a string, a byte slice, a bool or a number.
+func unmarshalText(v reflect.Value, text []byte) (bool, error) {
+	switch {
+	case isStringKind(v):
+		if v.Kind() == reflect.String {
+			v.SetString(string(text))
+		} else {
+			v.SetBytes(append([]byte{}, text...))
+		}
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(string(text))
+		if err != nil {
+			return true, err
+		}
+
+		v.SetBool(b)
+	case isIntKind(v.Kind()):
+		n, err := strconv.ParseInt(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		v.SetInt(n)
+	case isUintKind(v.Kind()):
+		n, err := strconv.ParseUint(string(text), 10, v.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		v.SetUint(n)
+	case isFloatKind(v.Kind()):
+		f, err := strconv.ParseFloat(string(text), v.Type().Bits())
+		if err != nil {
+			return true, err
+		}
+
+		v.SetFloat(f)
+	default:
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// Scan implements the sql.Scanner interface.
+//
+// A NULL value resets the Optional. Otherwise, `src` is assigned to T if
+// *T implements sql.Scanner or encoding.TextUnmarshaler, or if `src` is convertible to T.
+// Bools and numbers can also be scanned from text, and bools from the integers 0 and 1.
+func (opt *Optional[T]) Scan(src any) error {
+	if src == nil {
+		opt.Reset()
+		return nil
+	}
+
+	var v T
+	if err := scanValue(&v, src); err != nil {
+		return err
+	}
+
+	opt.Set(v)
+
+	return nil
+}
+
+func scanValue[T any](dst *T, src any) error {
+	switch vp := any(dst).(type) {
+	case sql.Scanner:
+		return vp.Scan(src)
+	case encoding.TextUnmarshaler:
+		switch sv := src.(type) {
+		case []byte:
+			return vp.UnmarshalText(sv)
+		case string:
+			return vp.UnmarshalText([]byte(sv))
+		}
+	}
+
+	dv := reflect.ValueOf(dst).Elem()
+	sv := reflect.ValueOf(src)
+
+	switch {
+	case sv.Type().AssignableTo(dv.Type()):
+		// the driver might reuse the byte slice
+		if b, ok := src.([]byte); ok {
+			sv = reflect.ValueOf(append([]byte{}, b...))
+		}
+
+		dv.Set(sv)
+	case isNumericKind(sv.Kind()) && isNumericKind(dv.Kind()):
+		if !convertNumber(dv, sv) {
+			return fmt.Errorf("gtl: cannot scan %v into Optional[%T]: value out of range", src, *dst)
+		}
+	case isStringKind(sv) && isStringKind(dv):
+		dv.Set(sv.Convert(dv.Type()))
+	case isStringKind(sv) && (dv.Kind() == reflect.Bool || isNumericKind(dv.Kind())):
+		// some drivers send the numbers and bools as text
+		text := []byte(sv.String())
+		if sv.Kind() != reflect.String {
+			text = sv.Bytes()
+		}
+
+		if _, err := unmarshalText(dv, text); err != nil {
+			return fmt.Errorf("gtl: cannot scan %q into Optional[%T]: %w", text, *dst, err)
+		}
+	case isIntKind(sv.Kind()) && dv.Kind() == reflect.Bool:
+		// some drivers send the bools as 0 or 1
+		if n := sv.Int(); n != 0 && n != 1 {
+			return fmt.Errorf("gtl: cannot scan %d into Optional[%T]", n, *dst)
+		}
+
+		dv.SetBool(sv.Int() == 1)
+	default:
+		return fmt.Errorf("gtl: cannot scan %T into Optional[%T]", src, *dst)
+	}
+
+	return nil
+}
+
+// convertNumber sets the number `sv` to `dv`.
+//
+// Returns false, leaving `dv` untouched, if `sv` doesn't fit in `dv` or if a float has a fractional part.
+func convertNumber(dv, sv reflect.Value) bool {
+	switch {
+	case isIntKind(dv.Kind()):
+		var n int64
+
+		switch {
+		case isIntKind(sv.Kind()):
+			n = sv.Int()
+		case isUintKind(sv.Kind()):
+			if sv.Uint() > math.MaxInt64 {
+				return false
+			}
+
+			n = int64(sv.Uint())
+		default:
+			f := sv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+				return false
+			}
+
+			n = int64(f)
+		}
+
+		if dv.OverflowInt(n) {
+			return false
+		}
+
+		dv.SetInt(n)
+	case isUintKind(dv.Kind()):
+		var n uint64
+
+		switch {
+		case isIntKind(sv.Kind()):
+			if sv.Int() < 0 {
+				return false
+			}
+
+			n = uint64(sv.Int())
+		case isUintKind(sv.Kind()):
+			n = sv.Uint()
+		default:
+			f := sv.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 {
+				return false
+			}
+
+			n = uint64(f)
+		}
+
+		if dv.OverflowUint(n) {
+			return false
+		}
+
+		dv.SetUint(n)
+	default:
+		if isFloatKind(sv.Kind()) && dv.OverflowFloat(sv.Float()) {
+			return false
+		}
+
+		dv.Set(sv.Convert(dv.Type()))
+	}
+
+	return true
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Float64
+}
+
+func isIntKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
+
+func isUintKind(k reflect.Kind) bool {
+	return k >= reflect.Uint && k <= reflect.Uintptr
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isStringKind(v reflect.Value) bool {
+	return v.Kind() == reflect.String ||
+		(v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8)
+}
+
+// Valuer returns a driver.Valuer for the Optional, so it can be used as a query argument.
+//
+// The Optional can't implement driver.Valuer itself because Value already returns the held value.
+// If the Optional is not holding any value, the driver.Valuer returns NULL.
+func (opt Optional[T]) Valuer() driver.Valuer {
+	return optionalValuer[T](opt)
+}
+
+type optionalValuer[T any] Optional[T]
+
+func (v optionalValuer[T]) Value() (driver.Value, error) {
+	if !v.hasValue {
+		return nil, nil
+	}
+
+	if valuer, ok := any(v.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(v.value)
+}
@@ -0,0 +1,274 @@
+package gtl
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+type optionalRow struct {
+	Name  Optional[string]  `json:"name"`
+	Price Optional[float64] `json:"price"`
+}
+
+// ptrMarshaler implements the marshalers on its pointer.
+type ptrMarshaler struct {
+	A int
+}
+
+func (*ptrMarshaler) MarshalJSON() ([]byte, error) {
+	return []byte(`"custom"`), nil
+}
+
+func (*ptrMarshaler) MarshalText() ([]byte, error) {
+	return []byte("custom"), nil
+}
+
+func TestOptionalJSON(t *testing.T) {
+	row := optionalRow{
+		Name: OptionalFrom("BTC"),
+	}
+
+	data, err := json.Marshal(row)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"name":"BTC","price":null}` {
+		t.Fatalf("unexpected json: %s", data)
+	}
+
+	var decoded optionalRow
+	if err := json.Unmarshal([]byte(`{"name":null,"price":20.5}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Name.HasValue() {
+		t.Fatal("name should be empty")
+	}
+
+	if !decoded.Price.HasValue() || decoded.Price.Get() != 20.5 {
+		t.Fatalf("unexpected price: %v", decoded.Price.Get())
+	}
+
+	custom := OptionalFrom(ptrMarshaler{A: 1})
+
+	if data, err := json.Marshal(custom); err != nil || string(data) != `"custom"` {
+		t.Fatalf("unexpected json: %s %v", data, err)
+	}
+
+	if text, err := custom.MarshalText(); err != nil || string(text) != "custom" {
+		t.Fatalf("unexpected text: %s %v", text, err)
+	}
+}
+
+func TestOptionalText(t *testing.T) {
+	var opt Optional[string]
+
+	if err := opt.UnmarshalText([]byte("hello")); err != nil {
+		t.Fatal(err)
+	}
+
+	text, err := opt.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(text) != "hello" {
+		t.Fatalf("unexpected text: %s", text)
+	}
+
+	if err := opt.UnmarshalText(nil); err != nil || opt.HasValue() {
+		t.Fatal("empty text should reset the optional")
+	}
+
+	var n Optional[int8]
+
+	if err := n.UnmarshalText([]byte("-12")); err != nil || n.Get() != -12 {
+		t.Fatalf("unexpected value: %d %v", n.Get(), err)
+	}
+
+	if text, err := n.MarshalText(); err != nil || string(text) != "-12" {
+		t.Fatalf("unexpected text: %s %v", text, err)
+	}
+
+	if err := n.UnmarshalText([]byte("300")); err == nil {
+		t.Fatal("out of range text should fail")
+	}
+
+	type xmlRow struct {
+		N Optional[float64]
+		B Optional[bool]
+	}
+
+	out, err := xml.Marshal(xmlRow{N: OptionalFrom(1.5), B: OptionalFrom(true)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(out) != "<xmlRow><N>1.5</N><B>true</B></xmlRow>" {
+		t.Fatalf("unexpected xml: %s", out)
+	}
+}
+
+func TestOptionalSQL(t *testing.T) {
+	var opt Optional[int]
+
+	if err := opt.Scan(int64(20)); err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.HasValue() || opt.Get() != 20 {
+		t.Fatalf("unexpected value: %v", opt.Get())
+	}
+
+	if err := opt.Scan(3.7); err == nil || opt.Get() != 20 {
+		t.Fatalf("lossy float scan should fail: %v %v", opt.Get(), err)
+	}
+
+	if err := opt.Scan(4.0); err != nil || opt.Get() != 4 {
+		t.Fatalf("unexpected value: %v %v", opt.Get(), err)
+	}
+
+	var small Optional[int8]
+
+	if err := small.Scan(int64(300)); err == nil || small.HasValue() {
+		t.Fatalf("out of range scan should fail: %v %v", small.Get(), err)
+	}
+
+	var unsigned Optional[uint]
+
+	if err := unsigned.Scan(int64(-1)); err == nil || unsigned.HasValue() {
+		t.Fatalf("negative scan should fail: %v %v", unsigned.Get(), err)
+	}
+
+	if err := opt.Scan([]byte("21")); err != nil || opt.Get() != 21 {
+		t.Fatalf("unexpected value: %v %v", opt.Get(), err)
+	}
+
+	if err := opt.Scan("abc1"); err == nil || opt.Get() != 21 {
+		t.Fatalf("invalid text scan should fail: %v %v", opt.Get(), err)
+	}
+
+	var f64 Optional[float64]
+
+	if err := f64.Scan([]byte("1.5")); err != nil || f64.Get() != 1.5 {
+		t.Fatalf("unexpected value: %v %v", f64.Get(), err)
+	}
+
+	var b Optional[bool]
+
+	if err := b.Scan(int64(1)); err != nil || !b.Get() {
+		t.Fatalf("unexpected value: %v %v", b.Get(), err)
+	}
+
+	if err := b.Scan("false"); err != nil || b.Get() {
+		t.Fatalf("unexpected value: %v %v", b.Get(), err)
+	}
+
+	if err := b.Scan(int64(2)); err == nil {
+		t.Fatal("scanning 2 into a bool should fail")
+	}
+
+	var f32 Optional[float32]
+
+	if err := f32.Scan(math.MaxFloat64); err == nil || f32.HasValue() {
+		t.Fatalf("out of range scan should fail: %v %v", f32.Get(), err)
+	}
+
+	if err := opt.Scan(int64(20)); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := opt.Valuer().Value()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if v != int64(20) {
+		t.Fatalf("unexpected driver value: %v", v)
+	}
+
+	if err := opt.Scan(nil); err != nil || opt.HasValue() {
+		t.Fatal("NULL should reset the optional")
+	}
+
+	if v, _ := opt.Valuer().Value(); v != nil {
+		t.Fatalf("expected NULL, got %v", v)
+	}
+
+	if err := opt.Scan("abc"); err == nil {
+		t.Fatal("expected an error scanning a string into an int")
+	}
+
+	var str Optional[string]
+	if err := str.Scan([]byte("abc")); err != nil || str.Get() != "abc" {
+		t.Fatalf("unexpected value: %v %v", str.Get(), err)
+	}
+}
+
+func TestOptionalCombinators(t *testing.T) {
+	opt := MapOptional(OptionalFrom(20), strconv.Itoa)
+	if opt.Get() != "20" {
+		t.Fatalf("expected 20, got %s", opt.Get())
+	}
+
+	var empty Optional[int]
+	if MapOptional(empty, strconv.Itoa).HasValue() {
+		t.Fatal("mapping an empty optional returned a value")
+	}
+
+	even := func(n int) bool {
+		return n%2 == 0
+	}
+
+	if !OptionalFrom(2).Filter(even).HasValue() || OptionalFrom(3).Filter(even).HasValue() {
+		t.Fatal("unexpected filter result")
+	}
+
+	half := func(n int) Optional[int] {
+		return OptionalWithCond(n/2, even(n))
+	}
+
+	if r := FlatMapOptional(OptionalFrom(8), half); r.Get() != 4 {
+		t.Fatalf("expected 4, got %d", r.Get())
+	}
+
+	if FlatMapOptional(OptionalFrom(7), half).HasValue() {
+		t.Fatal("7 is not even")
+	}
+
+	pair := ZipOptional(OptionalFrom(1), OptionalFrom("a"))
+	if !pair.HasValue() || pair.Get().First() != 1 || pair.Get().Second() != "a" {
+		t.Fatal("unexpected zip result")
+	}
+
+	if ZipOptional(empty, OptionalFrom("a")).HasValue() {
+		t.Fatal("zip with an empty optional returned a value")
+	}
+}
+
+func TestOptionalResult(t *testing.T) {
+	errEmpty := errors.New("empty")
+
+	var empty Optional[int]
+	if r := empty.OkOr(errEmpty); r.Error() != errEmpty {
+		t.Fatalf("expected empty error, got %v", r.Error())
+	}
+
+	r := OptionalFrom(1).OkOr(errEmpty)
+	if !r.IsOk() || r.Get() != 1 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	if opt := r.ToOptional(); opt.Get() != 1 {
+		t.Fatalf("expected 1, got %d", opt.Get())
+	}
+
+	if r.Err(errEmpty).ToOptional().HasValue() {
+		t.Fatal("a failed result converted to a valid optional")
+	}
+}
@@ -60,3 +60,26 @@ func (q *Queue[T]) Pop() (v Optional[T]) {
 
 	return
 }
+
+// Clone returns a copy of the queue keeping the order of the elements.
+func (q *Queue[T]) Clone() (r Queue[T]) {
+	for e := q.first; e != nil; e = e.next {
+		r.PushBack(e.data)
+	}
+
+	return r
+}
+
+// Iter returns an iterator over the queue, from front to back.
+func (q *Queue[T]) Iter() Iterator[T] {
+	return &Iter[T, *element[T]]{
+		index: q.first,
+		next: func(e *element[T]) (*T, *element[T]) {
+			if e == nil {
+				return nil, nil
+			}
+
+			return &e.data, e.next
+		},
+	}
+}
@@ -1,7 +1,9 @@
 package gtl
 
 import (
+	"errors"
 	"fmt"
+	"runtime/debug"
 )
 
 // Result represents the result of a function.
@@ -19,6 +21,42 @@ func MakeResult[T any](v T, err error) (r Result[T]) {
 	return r.Any(v, err)
 }
 
+// PanicError is the error held by a Result when the function passed to Try panics.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine at the moment of the panic.
+	Stack []byte
+}
+
+// Error returns the panic value formatted as an error message.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Try calls `fn` and returns a Result from the returned values.
+//
+// If `fn` panics, the panic is recovered and the Result holds a *PanicError
+// containing the panic value and the stack trace.
+func Try[T any](fn func() (T, error)) (r Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = r.Err(&PanicError{
+				Value: v,
+				Stack: debug.Stack(),
+			})
+		}
+	}()
+
+	return r.Any(fn())
+}
+
 // Ok returns a Result holding an expected value.
 //
 // If `r` was previously holding an unexpected value that is discarded.
@@ -96,6 +134,45 @@ func (r Result[T]) Error() error {
 	return r.err
 }
 
+// Context wraps the error held by `r`, if any, using the format `msg: error`.
+//
+// The original error can still be checked using errors.Is or errors.As.
+func (r Result[T]) Context(msg string) Result[T] {
+	if r.err != nil {
+		r.err = fmt.Errorf("%s: %w", msg, r.err)
+	}
+
+	return r
+}
+
+// Contextf is like Context but formats the message according to a format specifier.
+func (r Result[T]) Contextf(format string, args ...any) Result[T] {
+	if r.err != nil {
+		return r.Context(fmt.Sprintf(format, args...))
+	}
+
+	return r
+}
+
+// IsErr reports whether the error held by `r` matches `target` using errors.Is.
+func (r Result[T]) IsErr(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// AsErr finds the first error in the held error's chain that matches `target` using errors.As.
+//
+// If no error is held, AsErr returns false.
+func (r Result[T]) AsErr(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// ToOptional converts the Result into an Optional, discarding the error.
+//
+// If `r` is holding an error, an empty Optional is returned.
+func (r Result[T]) ToOptional() Optional[T] {
+	return OptionalWithCond(r.value, r.err == nil)
+}
+
 // Both returns the value and the error.
 func (r Result[T]) Both() (T, error) {
 	return r.Get(), r.Error()
@@ -142,3 +219,110 @@ func (r Result[T]) ElseE(fn func(error) error) Result[T] {
 
 	return r
 }
+
+// MapResult converts a Result[T] into a Result[U] by passing the holding value to `fn`.
+//
+// If `r` is holding an error, `fn` is not called and the error is carried to the returned Result.
+func MapResult[T, U any](r Result[T], fn func(T) U) (res Result[U]) {
+	if r.err != nil {
+		return res.Err(r.err)
+	}
+
+	return res.Ok(fn(r.value))
+}
+
+// AndThen is like MapResult but `fn` returns a Result itself, which can hold an error.
+//
+// AndThen is useful to chain functions of different types that might fail.
+func AndThen[T, U any](r Result[T], fn func(T) Result[U]) (res Result[U]) {
+	if r.err != nil {
+		return res.Err(r.err)
+	}
+
+	return fn(r.value)
+}
+
+// MapErr passes the error held by `r` to `fn`, if any. The returned error replaces the held one.
+//
+// MapErr is useful to add context to an error before passing the Result to the next step.
+func MapErr[T any](r Result[T], fn func(error) error) Result[T] {
+	if r.err != nil {
+		return r.Err(fn(r.err))
+	}
+
+	return r
+}
+
+// FlattenResult removes one level of nesting from `r`.
+//
+// If the outer Result is holding an error, that error is returned. Otherwise, the inner Result is returned.
+func FlattenResult[T any](r Result[Result[T]]) (res Result[T]) {
+	if r.err != nil {
+		return res.Err(r.err)
+	}
+
+	return r.value
+}
+
+// CollectResults gathers the values held by `rs` into a single Result.
+//
+// CollectResults stops at the first Result holding an error and returns that error.
+func CollectResults[T any](rs []Result[T]) Result[[]T] {
+	vc := Vec[Result[T]](rs)
+	return CollectResultsIter(vc.Iter())
+}
+
+// CollectResultsIter is like CollectResults but gathers the values from an Iterator.
+func CollectResultsIter[T any](it Iterator[Result[T]]) (res Result[[]T]) {
+	values := make([]T, 0)
+
+	for it.Next() {
+		r := it.Get()
+		if r.err != nil {
+			return res.Err(r.err)
+		}
+
+		values = append(values, r.value)
+	}
+
+	return res.Ok(values)
+}
+
+// JoinResults gathers the values held by `rs` into a single Result.
+//
+// Unlike CollectResults, JoinResults doesn't stop at the first error. If any error is found,
+// all of them are joined using errors.Join and the returned Result holds the joined error.
+func JoinResults[T any](rs []Result[T]) Result[[]T] {
+	vc := Vec[Result[T]](rs)
+	return JoinResultsIter(vc.Iter())
+}
+
+// JoinResultsIter is like JoinResults but gathers the values from an Iterator.
+func JoinResultsIter[T any](it Iterator[Result[T]]) (res Result[[]T]) {
+	values, errs := PartitionResultsIter(it)
+	if len(errs) != 0 {
+		return res.Err(errors.Join(errs...))
+	}
+
+	return res.Ok(values)
+}
+
+// PartitionResults splits `rs` into the values and the errors held by each Result.
+func PartitionResults[T any](rs []Result[T]) ([]T, []error) {
+	vc := Vec[Result[T]](rs)
+	return PartitionResultsIter(vc.Iter())
+}
+
+// PartitionResultsIter is like PartitionResults but takes the Results from an Iterator.
+func PartitionResultsIter[T any](it Iterator[Result[T]]) (values []T, errs []error) {
+	for it.Next() {
+		r := it.Get()
+		if r.err != nil {
+			errs = append(errs, r.err)
+		} else {
+			values = append(values, r.value)
+		}
+	}
+
+	return
+}
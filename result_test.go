@@ -0,0 +1,175 @@
+package gtl
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResultAndThen(t *testing.T) {
+	parse := func(s string) Result[int] {
+		return MakeResult(strconv.Atoi(s))
+	}
+
+	r := AndThen(MakeResult("1234", nil), parse)
+	if !r.IsOk() || r.Get() != 1234 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	r = AndThen(MakeResult("12a4", nil), parse)
+	if r.IsOk() {
+		t.Fatal("expected an error")
+	}
+
+	errOuter := errors.New("outer")
+
+	r = AndThen(MakeResult("", errOuter), parse)
+	if r.Error() != errOuter {
+		t.Fatalf("expected outer error, got %v", r.Error())
+	}
+}
+
+func TestResultMap(t *testing.T) {
+	r := MapResult(MakeResult(20, nil), strconv.Itoa)
+	if r.Get() != "20" {
+		t.Fatalf("expected 20, got %s", r.Get())
+	}
+
+	errMap := errors.New("map")
+
+	r = MapResult(MakeResult(0, errMap), strconv.Itoa)
+	if r.Error() != errMap || r.Get() != "" {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	errCtx := errors.New("ctx")
+
+	r = MapErr(r, func(err error) error {
+		return errCtx
+	})
+	if r.Error() != errCtx {
+		t.Fatalf("expected ctx error, got %v", r.Error())
+	}
+}
+
+func TestFlattenResult(t *testing.T) {
+	var (
+		inner Result[int]
+		outer Result[Result[int]]
+	)
+
+	r := FlattenResult(outer.Ok(inner.Ok(1)))
+	if !r.IsOk() || r.Get() != 1 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	errInner := errors.New("inner")
+
+	r = FlattenResult(outer.Ok(inner.Err(errInner)))
+	if r.Error() != errInner {
+		t.Fatalf("expected inner error, got %v", r.Error())
+	}
+
+	errOuter := errors.New("outer")
+
+	r = FlattenResult(outer.Err(errOuter))
+	if r.Error() != errOuter {
+		t.Fatalf("expected outer error, got %v", r.Error())
+	}
+}
+
+func TestCollectResults(t *testing.T) {
+	var r Result[int]
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+
+	rs := []Result[int]{
+		r.Ok(1), r.Err(errA), r.Ok(2), r.Err(errB),
+	}
+
+	if res := CollectResults(rs[:1]); !res.IsOk() || len(res.Get()) != 1 {
+		t.Fatalf("unexpected result: %v %v", res.Get(), res.Error())
+	}
+
+	if res := CollectResults(rs); res.Error() != errA {
+		t.Fatalf("expected error a, got %v", res.Error())
+	}
+
+	res := JoinResults(rs)
+	if !errors.Is(res.Error(), errA) || !errors.Is(res.Error(), errB) {
+		t.Fatalf("expected both errors, got %v", res.Error())
+	}
+
+	values, errs := PartitionResults(rs)
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Fatalf("unexpected values: %v", values)
+	}
+
+	if len(errs) != 2 || errs[0] != errA || errs[1] != errB {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+func TestCollectResultsReceiver(t *testing.T) {
+	ch := MakeChan[Result[int]](10)
+	sender, recv := ch.Split()
+
+	var r Result[int]
+	for i := 0; i < 10; i++ {
+		sender.Send(r.Ok(i))
+	}
+
+	ch.Close()
+
+	res := CollectResultsIter(recv.Iter())
+	if !res.IsOk() || len(res.Get()) != 10 {
+		t.Fatalf("unexpected result: %v %v", res.Get(), res.Error())
+	}
+
+	for i, v := range res.Get() {
+		if v != i {
+			t.Fatalf("expected %d, got %d", i, v)
+		}
+	}
+}
+
+func TestResultTry(t *testing.T) {
+	r := Try(func() (int, error) {
+		return strconv.Atoi("12")
+	})
+	if !r.IsOk() || r.Get() != 12 {
+		t.Fatalf("unexpected result: %v %v", r.Get(), r.Error())
+	}
+
+	r = Try(func() (int, error) {
+		var vc Vec[int]
+		return vc.Get(2), nil
+	})
+
+	var perr *PanicError
+	if !r.AsErr(&perr) {
+		t.Fatalf("expected a panic error, got %v", r.Error())
+	}
+
+	if len(perr.Stack) == 0 {
+		t.Fatal("empty stack trace")
+	}
+}
+
+func TestResultContext(t *testing.T) {
+	errBase := errors.New("base")
+
+	r := MakeResult(0, errBase).Context("reading").Contextf("file %s", "a.txt")
+	if r.Error().Error() != "file a.txt: reading: base" {
+		t.Fatalf("unexpected error message: %s", r.Error())
+	}
+
+	if !r.IsErr(errBase) {
+		t.Fatal("expected the base error in the chain")
+	}
+
+	if MakeResult(1, nil).Context("reading").Error() != nil {
+		t.Fatal("Context set an error")
+	}
+}
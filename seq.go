@@ -0,0 +1,152 @@
+//go:build go1.23
+
+package gtl
+
+import "iter"
+
+// FromSeq converts an iter.Seq into an Iterator.
+//
+// The returned function must be called to release the resources
+// if the Iterator is not consumed until the end.
+func FromSeq[T any](seq iter.Seq[T]) (Iterator[T], func()) {
+	next, stop := iter.Pull(seq)
+
+	return iterFrom(func() *T {
+		v, ok := next()
+		if !ok {
+			return nil
+		}
+
+		return &v
+	}), stop
+}
+
+// ToSeq converts an Iterator into an iter.Seq.
+func ToSeq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for it.Next() {
+			if !yield(it.Get()) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the index-value pairs of the vector.
+func (vc Vec[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range vc {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the index-value pairs of the vector, traversing it backward.
+func (vc Vec[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(vc) - 1; i >= 0; i-- {
+			if !yield(i, vc[i]) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the index-byte pairs of Bytes.
+func (b Bytes) All() iter.Seq2[int, byte] {
+	return Vec[byte](b).All()
+}
+
+// Backward returns an iterator over the index-byte pairs of Bytes, traversing it backward.
+func (b Bytes) Backward() iter.Seq2[int, byte] {
+	return Vec[byte](b).Backward()
+}
+
+// All returns an iterator over the values of the linked list.
+func (lst *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := lst.next; e != nil; e = e.next {
+			if !yield(e.v) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the linked list, traversing it backward.
+//
+// As the list is singly linked, Backward needs to collect the elements before yielding them.
+func (lst *List[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var elmnts []*listElement[T]
+		for e := lst.next; e != nil; e = e.next {
+			elmnts = append(elmnts, e)
+		}
+
+		for i := len(elmnts) - 1; i >= 0; i-- {
+			if !yield(elmnts[i].v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the values of the queue, from front to back.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := q.first; e != nil; e = e.next {
+			if !yield(e.data) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over the values of the queue, from back to front.
+//
+// As the queue is singly linked, Backward needs to collect the elements before yielding them.
+func (q *Queue[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var elmnts []*element[T]
+		for e := q.first; e != nil; e = e.next {
+			elmnts = append(elmnts, e)
+		}
+
+		for i := len(elmnts) - 1; i >= 0; i-- {
+			if !yield(elmnts[i].data) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over all the nodes of the tree.
+//
+// The nodes are traveled in the same order as RangeAll does.
+func (tree *Tree[Key, Value]) All() iter.Seq[*Tree[Key, Value]] {
+	return func(yield func(*Tree[Key, Value]) bool) {
+		tree.RangeAll(yield)
+	}
+}
+
+// Backward returns an iterator over all the nodes of the tree in the reverse order of All.
+func (tree *Tree[Key, Value]) Backward() iter.Seq[*Tree[Key, Value]] {
+	return func(yield func(*Tree[Key, Value]) bool) {
+		for i := len(tree.nodes) - 1; i >= 0; i-- {
+			if !tree.nodes[i].travelBackward(yield) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the values received from the channel.
+//
+// The iterator stops when the channel is closed.
+func (r Receiver[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		r.RangeBool(yield)
+	}
+}
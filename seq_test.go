@@ -0,0 +1,105 @@
+//go:build go1.23
+
+package gtl
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSeqVec(t *testing.T) {
+	vc := NewVec(1, 2, 3)
+
+	var backward []int
+	for _, v := range vc.Backward() {
+		backward = append(backward, v)
+	}
+
+	if !slices.Equal(backward, []int{3, 2, 1}) {
+		t.Fatalf("unexpected values: %v", backward)
+	}
+
+	it, stop := FromSeq(slices.Values(vc))
+	defer stop()
+
+	expectVec(t, Collect(it), 1, 2, 3)
+
+	if vs := slices.Collect(ToSeq(vc.Iter())); !slices.Equal(vs, vc) {
+		t.Fatalf("unexpected values: %v", vs)
+	}
+}
+
+func TestSeqContainers(t *testing.T) {
+	var (
+		lst List[int]
+		q   Queue[int]
+	)
+
+	for i := 0; i < 3; i++ {
+		lst.Add(i)
+		q.PushBack(i)
+	}
+
+	if vs := slices.Collect(lst.All()); !slices.Equal(vs, []int{2, 1, 0}) {
+		t.Fatalf("unexpected list values: %v", vs)
+	}
+
+	if vs := slices.Collect(lst.Backward()); !slices.Equal(vs, []int{0, 1, 2}) {
+		t.Fatalf("unexpected list values: %v", vs)
+	}
+
+	if vs := slices.Collect(q.All()); !slices.Equal(vs, []int{0, 1, 2}) {
+		t.Fatalf("unexpected queue values: %v", vs)
+	}
+
+	if vs := slices.Collect(q.Backward()); !slices.Equal(vs, []int{2, 1, 0}) {
+		t.Fatalf("unexpected queue values: %v", vs)
+	}
+
+	var tree Tree[string, int]
+	tree.Set(1, "a", "b")
+	tree.Set(2, "c")
+
+	n := 0
+	for node := range tree.All() {
+		if node.Name() == "b" {
+			break
+		}
+
+		n++
+	}
+
+	if n != 0 {
+		t.Fatalf("expected b to be the first node, got %d nodes before", n)
+	}
+
+	var names []string
+	for node := range tree.Backward() {
+		names = append(names, node.Name())
+	}
+
+	if !slices.Equal(names, []string{"c", "a", "b"}) {
+		t.Fatalf("unexpected backward nodes: %v", names)
+	}
+
+	for node := range tree.Backward() {
+		if node.Name() != "c" {
+			t.Fatalf("expected c to be the first node, got %s", node.Name())
+		}
+
+		break
+	}
+
+	ch := MakeChan[int](3)
+	sender, recv := ch.Split()
+
+	for i := 0; i < 3; i++ {
+		sender.Send(i)
+	}
+
+	ch.Close()
+
+	if vs := slices.Collect(recv.All()); !slices.Equal(vs, []int{0, 1, 2}) {
+		t.Fatalf("unexpected channel values: %v", vs)
+	}
+}
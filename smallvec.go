@@ -0,0 +1,167 @@
+package gtl
+
+import "unsafe"
+
+// InlineArray defines the arrays that can be used as inline storage of a SmallVec.
+type InlineArray[T any] interface {
+	~[1]T | ~[2]T | ~[4]T | ~[8]T | ~[16]T | ~[32]T
+}
+
+// SmallVec is a vector that keeps up to len(A) elements inline, without allocating.
+//
+// When the number of elements exceeds the inline capacity, the elements are moved to the heap.
+// For example, SmallVec[int, [4]int] holds up to 4 integers without allocating.
+//
+// A SmallVec must not be copied after the first use.
+type SmallVec[T any, A InlineArray[T]] struct {
+	inline  A
+	n       int
+	heap    Vec[T]
+	spilled bool
+}
+
+// data returns a slice over the elements held by the vector.
+func (sv *SmallVec[T, A]) data() []T {
+	if sv.spilled {
+		return sv.heap
+	}
+
+	return unsafe.Slice((*T)(unsafe.Pointer(&sv.inline)), len(sv.inline))[:sv.n]
+}
+
+// spill moves the inline elements to the heap reserving capacity for `n` elements.
+func (sv *SmallVec[T, A]) spill(n int) {
+	heap := make(Vec[T], sv.n, Max(n, 2*len(sv.inline)))
+	copy(heap, sv.data())
+
+	var zero A
+	sv.inline = zero
+	sv.heap = heap
+	sv.spilled = true
+}
+
+// Append appends `elmnts` to the end of the vector.
+func (sv *SmallVec[T, A]) Append(elmnts ...T) {
+	if sv.spilled {
+		sv.heap.Append(elmnts...)
+		return
+	}
+
+	if n := sv.n + len(elmnts); n > len(sv.inline) {
+		sv.spill(n)
+		sv.heap.Append(elmnts...)
+
+		return
+	}
+
+	for _, e := range elmnts {
+		sv.inline[sv.n] = e
+		sv.n++
+	}
+}
+
+// PopBack returns the last element and removes it from the vector.
+func (sv *SmallVec[T, A]) PopBack() (e T) {
+	if sv.spilled {
+		return sv.heap.PopBack()
+	}
+
+	if sv.n > 0 {
+		var zero T
+
+		sv.n--
+		e, sv.inline[sv.n] = sv.inline[sv.n], zero
+	}
+
+	return e
+}
+
+// Get returns the element in the position `i`.
+func (sv *SmallVec[T, A]) Get(i int) T {
+	return sv.data()[i]
+}
+
+// Front returns the first element of the vector.
+func (sv *SmallVec[T, A]) Front() T {
+	return sv.data()[0]
+}
+
+// Back returns the last element of the vector.
+func (sv *SmallVec[T, A]) Back() T {
+	return sv.data()[sv.Len()-1]
+}
+
+// Len returns the number of elements in the vector.
+func (sv *SmallVec[T, A]) Len() int {
+	if sv.spilled {
+		return sv.heap.Len()
+	}
+
+	return sv.n
+}
+
+// Cap returns the capacity of the vector.
+func (sv *SmallVec[T, A]) Cap() int {
+	if sv.spilled {
+		return sv.heap.Cap()
+	}
+
+	return len(sv.inline)
+}
+
+// IsInline returns whether the elements are still stored inline.
+func (sv *SmallVec[T, A]) IsInline() bool {
+	return !sv.spilled
+}
+
+// Reserve ensures the vector has capacity for at least `n` elements, without changing the length.
+//
+// If `n` exceeds the inline capacity, the elements are moved to the heap.
+func (sv *SmallVec[T, A]) Reserve(n int) {
+	if n <= sv.Cap() {
+		return
+	}
+
+	if !sv.spilled {
+		sv.spill(n)
+		return
+	}
+
+	heap := make(Vec[T], sv.heap.Len(), n)
+	copy(heap, sv.heap)
+	sv.heap = heap
+}
+
+// Clear removes all the elements from the vector.
+//
+// If the elements were moved to the heap, the heap storage is kept for reuse.
+func (sv *SmallVec[T, A]) Clear() {
+	if sv.spilled {
+		sv.heap.Clear()
+		return
+	}
+
+	var zero A
+	sv.inline = zero
+	sv.n = 0
+}
+
+// Vec returns a Vec sharing the storage of the vector.
+//
+// The returned Vec is only valid until the next modification of the SmallVec.
+func (sv *SmallVec[T, A]) Vec() Vec[T] {
+	return sv.data()
+}
+
+// Iter returns an iterator over the vector.
+func (sv *SmallVec[T, A]) Iter() Iterator[T] {
+	return &Iter[T, int]{
+		next: func(i int) (*T, int) {
+			if i < sv.Len() {
+				return &sv.data()[i], i + 1
+			}
+
+			return nil, i
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package gtl
+
+import (
+	"testing"
+)
+
+func TestSmallVec(t *testing.T) {
+	var sv SmallVec[int, [4]int]
+
+	sv.Append(1, 2, 3)
+	if !sv.IsInline() || sv.Len() != 3 || sv.Cap() != 4 {
+		t.Fatal("elements should be inline")
+	}
+
+	sv.Append(4)
+	if !sv.IsInline() || sv.Back() != 4 {
+		t.Fatal("elements should be inline")
+	}
+
+	sv.Append(5)
+	if sv.IsInline() || sv.Len() != 5 {
+		t.Fatal("elements should be in the heap")
+	}
+
+	expectVec(t, Collect(sv.Iter()), 1, 2, 3, 4, 5)
+
+	if sv.PopBack() != 5 || sv.Len() != 4 {
+		t.Fatal("unexpected pop")
+	}
+
+	var sv2 SmallVec[string, [2]string]
+
+	sv2.Append("a", "b")
+	if sv2.PopBack() != "b" || sv2.PopBack() != "a" || sv2.PopBack() != "" {
+		t.Fatal("unexpected pop")
+	}
+
+	sv2.Reserve(10)
+	if sv2.IsInline() || sv2.Cap() < 10 || sv2.Len() != 0 {
+		t.Fatal("reserve failed")
+	}
+}
+
+func BenchmarkSmallVecAppend(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var sv SmallVec[int, [4]int]
+		for j := 0; j < 4; j++ {
+			sv.Append(j)
+		}
+
+		if sv.Len() != 4 {
+			b.Fatal("unexpected length")
+		}
+	}
+}
+
+func BenchmarkVecAppend(b *testing.B) {
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var vc Vec[int]
+		for j := 0; j < 4; j++ {
+			vc.Append(j)
+		}
+
+		if vc.Len() != 4 {
+			b.Fatal("unexpected length")
+		}
+	}
+}
+
+func BenchmarkSmallVecGet(b *testing.B) {
+	var sv SmallVec[int, [4]int]
+	sv.Append(1, 2, 3, 4)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		n := 0
+		for j := 0; j < sv.Len(); j++ {
+			n += sv.Get(j)
+		}
+
+		if n != 10 {
+			b.Fatal("unexpected sum")
+		}
+	}
+}
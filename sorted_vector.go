@@ -0,0 +1,148 @@
+package gtl
+
+import "golang.org/x/exp/constraints"
+
+// SortedVec is a vector that keeps its elements sorted.
+//
+// The elements can only be added through SortedVec's methods, so the sorted invariant is always kept.
+type SortedVec[T any] struct {
+	vc   Vec[T]
+	less func(a, b T) bool
+}
+
+// NewSortedVec returns a SortedVec holding `elmnts` in ascending order.
+func NewSortedVec[T constraints.Ordered](elmnts ...T) *SortedVec[T] {
+	return NewSortedVecFunc(less[T], elmnts...)
+}
+
+// NewSortedVecFunc returns a SortedVec holding `elmnts` sorted as determined by `less`.
+func NewSortedVecFunc[T any](less func(a, b T) bool, elmnts ...T) *SortedVec[T] {
+	sv := &SortedVec[T]{
+		vc:   NewVec(elmnts...),
+		less: less,
+	}
+	sv.vc.SortStableFunc(less)
+
+	return sv
+}
+
+// Insert inserts `elmnts` keeping the vector sorted.
+func (sv *SortedVec[T]) Insert(elmnts ...T) {
+	for _, e := range elmnts {
+		sv.vc.InsertSortedFunc(e, sv.less)
+	}
+}
+
+// Search searches for `v` in the vector.
+//
+// Returns the position of `v`, or the position where `v` would be inserted, and whether `v` was found.
+func (sv *SortedVec[T]) Search(v T) (int, bool) {
+	return sv.vc.BinarySearchFunc(v, sv.less)
+}
+
+// Contains returns whether `v` is in the vector.
+func (sv *SortedVec[T]) Contains(v T) bool {
+	_, ok := sv.Search(v)
+	return ok
+}
+
+// LowerBound returns the position of the first element that is not less than `v`.
+func (sv *SortedVec[T]) LowerBound(v T) int {
+	i, _ := sv.Search(v)
+	return i
+}
+
+// UpperBound returns the position of the first element that is greater than `v`.
+//
+// UpperBound(v)-1 is the position of the last element less or equal than `v`, if any.
+func (sv *SortedVec[T]) UpperBound(v T) int {
+	i, _ := sv.vc.BinarySearchFunc(v, func(a, b T) bool {
+		return !sv.less(b, a)
+	})
+
+	return i
+}
+
+// Remove removes the first element equal to `v`. Returns true if the element has been removed.
+func (sv *SortedVec[T]) Remove(v T) bool {
+	i, ok := sv.Search(v)
+	if ok {
+		sv.vc.DelByIndex(i)
+	}
+
+	return ok
+}
+
+// DelByIndex removes the element in the position `i`.
+//
+// Returns true if the element has been removed.
+func (sv *SortedVec[T]) DelByIndex(i int) (T, bool) {
+	return sv.vc.DelByIndex(i)
+}
+
+// Retain keeps only the elements for which `fn` returns true.
+func (sv *SortedVec[T]) Retain(fn func(T) bool) {
+	sv.vc.Retain(fn)
+}
+
+// Truncate shortens the vector to `n` elements.
+func (sv *SortedVec[T]) Truncate(n int) {
+	sv.vc.Truncate(n)
+}
+
+// Clear removes all the elements from the vector.
+func (sv *SortedVec[T]) Clear() {
+	sv.vc.Clear()
+}
+
+// Get returns the element in the position `i`.
+func (sv *SortedVec[T]) Get(i int) T {
+	return sv.vc.Get(i)
+}
+
+// At returns the element in the position `i`, or an empty Optional if `i` is out of range.
+func (sv *SortedVec[T]) At(i int) Optional[T] {
+	return sv.vc.At(i)
+}
+
+// Front returns the smallest element.
+func (sv *SortedVec[T]) Front() T {
+	return sv.vc.Front()
+}
+
+// Back returns the greatest element.
+func (sv *SortedVec[T]) Back() T {
+	return sv.vc.Back()
+}
+
+// PopFront returns the smallest element and removes it from the vector.
+func (sv *SortedVec[T]) PopFront() T {
+	return sv.vc.PopFront()
+}
+
+// PopBack returns the greatest element and removes it from the vector.
+func (sv *SortedVec[T]) PopBack() T {
+	return sv.vc.PopBack()
+}
+
+// Len returns the number of elements.
+func (sv *SortedVec[T]) Len() int {
+	return sv.vc.Len()
+}
+
+// Iter returns an iterator over the vector.
+func (sv *SortedVec[T]) Iter() Iterator[T] {
+	return sv.vc.Iter()
+}
+
+// ReverseIter returns an iterator that traverses the vector backward.
+func (sv *SortedVec[T]) ReverseIter() RandomAccessIterator[T] {
+	return sv.vc.ReverseIter()
+}
+
+// Vec returns the underlying vector.
+//
+// The returned vector must not be modified in a way that breaks the order.
+func (sv *SortedVec[T]) Vec() Vec[T] {
+	return sv.vc
+}
@@ -11,6 +11,27 @@ type Tree[Key comparable, Value any] struct {
 	nodes []*Tree[Key, Value]
 }
 
+// Clone returns a deep copy of the tree.
+//
+// The values are copied by assignment, so pointers held by the tree are shared with the copy.
+func (tree *Tree[Key, Value]) Clone() *Tree[Key, Value] {
+	nt := &Tree[Key, Value]{
+		data:  tree.data,
+		name:  tree.name,
+		path:  append([]Key(nil), tree.path...),
+		depth: tree.depth,
+	}
+
+	if tree.nodes != nil {
+		nt.nodes = make([]*Tree[Key, Value], len(tree.nodes))
+		for i, node := range tree.nodes {
+			nt.nodes[i] = node.Clone()
+		}
+	}
+
+	return nt
+}
+
 func (tree *Tree[Key, Value]) Trees() []*Tree[Key, Value] {
 	return tree.nodes
 }
@@ -85,7 +106,9 @@ func (tree *Tree[Key, Value]) Range(fn func(*Tree[Key, Value]) bool, path ...Key
 // RangeAll will travel all the nodes from the tree using a Depth-first search algo.
 func (tree *Tree[Key, Value]) RangeAll(fn func(*Tree[Key, Value]) bool) {
 	for _, child := range tree.nodes {
-		child.travel(-1, fn)
+		if !child.travel(-1, fn) {
+			break
+		}
 	}
 }
 
@@ -145,6 +168,21 @@ func (tree *Tree[Key, Value]) travel(maxDepth int, fn func(*Tree[Key, Value]) bo
 	return fn(tree)
 }
 
+// travelBackward travels the tree in the reverse order of travel.
+func (tree *Tree[Key, Value]) travelBackward(fn func(*Tree[Key, Value]) bool) bool {
+	if !fn(tree) {
+		return false
+	}
+
+	for i := len(tree.nodes) - 1; i >= 0; i-- {
+		if !tree.nodes[i].travelBackward(fn) {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (tree *Tree[Key, Value]) getLastTree(path ...Key) *Tree[Key, Value] {
 	if len(path) == 0 {
 		return tree
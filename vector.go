@@ -1,5 +1,10 @@
 package gtl
 
+import "errors"
+
+// ErrOutOfRange is returned when an index is out of the bounds of a vector.
+var ErrOutOfRange = errors.New("gtl: index out of range")
+
 // Vec represents a slice of type `T`.
 type Vec[T any] []T
 
@@ -18,6 +23,17 @@ func NewVecSize[T any](size, capacity int) Vec[T] {
 	return (Vec[T])(make([]T, size, capacity))
 }
 
+// Clone returns a copy of the vector.
+//
+// The elements are copied by assignment, so pointers held by the vector are shared with the copy.
+func (vc Vec[T]) Clone() Vec[T] {
+	if vc == nil {
+		return nil
+	}
+
+	return append(make(Vec[T], 0, len(vc)), vc...)
+}
+
 // Get returns the element in the position `i`.
 func (vc *Vec[T]) Get(i int) T {
 	return (*vc)[i]
@@ -102,8 +118,8 @@ func (vc *Vec[T]) Cap() int {
 //
 // Returns true if the element has been removed.
 func (vc *Vec[T]) Del(it Iterator[T]) (val T, erased bool) {
-	nit, ok := it.(*Iter[T, int])
-	if ok {
+	nit, ok := it.(*vecIter[T])
+	if ok && nit.valid() {
 		return vc.DelByIndex(nit.Index())
 	}
 
@@ -117,7 +133,7 @@ func (vc *Vec[T]) Filter(cmpFn func(it Iterator[T]) bool) {
 	for it := vc.iter(); it.Next(); {
 		if !cmpFn(it) {
 			vc.DelByIndex(it.Index())
-			it.index = it.prev
+			it.Prev()
 		}
 	}
 }
@@ -136,32 +152,212 @@ func (vc *Vec[T]) DelByIndex(i int) (val T, erased bool) {
 	return val, true
 }
 
+// At returns the element in the position `i`.
+//
+// Unlike Get, if `i` is out of range an empty Optional is returned.
+func (vc *Vec[T]) At(i int) (opt Optional[T]) {
+	if i >= 0 && i < vc.Len() {
+		opt.Set((*vc)[i])
+	}
+
+	return opt
+}
+
+// Insert inserts `elmnts` in the position `i`, moving the following elements to the right.
+//
+// Returns ErrOutOfRange if `i` is not in the range [0, vc.Len()].
+func (vc *Vec[T]) Insert(i int, elmnts ...T) error {
+	return vc.Splice(i, i, elmnts...)
+}
+
+// InsertVec is like Insert but inserts all the elements of `other`.
+func (vc *Vec[T]) InsertVec(i int, other Vec[T]) error {
+	return vc.Splice(i, i, other...)
+}
+
+// Splice replaces the elements in the range [i, j) with `replacement`.
+//
+// Returns ErrOutOfRange if the range is not valid.
+func (vc *Vec[T]) Splice(i, j int, replacement ...T) error {
+	n := vc.Len()
+	if i < 0 || j < i || j > n {
+		return ErrOutOfRange
+	}
+
+	s := *vc
+
+	diff := len(replacement) - (j - i)
+	if diff > 0 {
+		s = append(s, make([]T, diff)...)
+		copy(s[j+diff:], s[j:n])
+	} else if diff < 0 {
+		copy(s[j+diff:], s[j:])
+		zeroElements(s[n+diff:])
+		s = s[:n+diff]
+	}
+
+	copy(s[i:], replacement)
+	*vc = s
+
+	return nil
+}
+
+// SwapRemove removes the element in the position `i` replacing it with the last element.
+//
+// SwapRemove doesn't preserve the order of the elements, but it runs in O(1).
+// If `i` is out of range, an empty Optional is returned.
+func (vc *Vec[T]) SwapRemove(i int) (opt Optional[T]) {
+	n := vc.Len()
+	if i < 0 || i >= n {
+		return opt
+	}
+
+	opt.Set((*vc)[i])
+
+	(*vc)[i] = (*vc)[n-1]
+	zeroElements((*vc)[n-1:])
+	*vc = (*vc)[:n-1]
+
+	return opt
+}
+
+// Truncate shortens the vector to `n` elements.
+//
+// If `n` is greater or equal than the vector's length, Truncate has no effect.
+func (vc *Vec[T]) Truncate(n int) {
+	if n = Max(n, 0); n < vc.Len() {
+		zeroElements((*vc)[n:])
+		*vc = (*vc)[:n]
+	}
+}
+
+// Drain removes the elements in the range [i, j) and returns them in a new vector.
+//
+// Returns ErrOutOfRange if the range is not valid.
+func (vc *Vec[T]) Drain(i, j int) (r Result[Vec[T]]) {
+	if i < 0 || j < i || j > vc.Len() {
+		return r.Err(ErrOutOfRange)
+	}
+
+	drained := NewVec((*vc)[i:j]...)
+	vc.Splice(i, j)
+
+	return r.Ok(drained)
+}
+
+// Clear removes all the elements from the vector keeping the capacity.
+func (vc *Vec[T]) Clear() {
+	vc.Truncate(0)
+}
+
+// Retain keeps only the elements for which `fn` returns true, preserving the order.
+func (vc *Vec[T]) Retain(fn func(T) bool) {
+	n := 0
+	for _, e := range *vc {
+		if fn(e) {
+			(*vc)[n] = e
+			n++
+		}
+	}
+
+	vc.Truncate(n)
+}
+
+// zeroElements sets all the elements of `s` to the zero value,
+// so the garbage collector can release what they were pointing to.
+func zeroElements[T any](s []T) {
+	var zero T
+	for i := range s {
+		s[i] = zero
+	}
+}
+
 // Iter returns an iterator over the vector.
-func (vc *Vec[T]) Iter() Iterator[T] {
+func (vc *Vec[T]) Iter() RandomAccessIterator[T] {
 	return vc.iter()
 }
 
-func (vc *Vec[T]) iter() *Iter[T, int] {
-	it := &Iter[T, int]{
-		v: nil,
-		next: func(cnt int) (*T, int) {
-			if cnt < vc.Len() {
-				return &(*vc)[cnt], cnt + 1
-			}
+// ReverseIter returns an iterator that traverses the vector backward.
+//
+// Index, Seek and Get keep referring to the positions of the vector,
+// but Next moves towards the front and Prev towards the back.
+func (vc *Vec[T]) ReverseIter() RandomAccessIterator[T] {
+	return &vecIter[T]{
+		vc:      vc,
+		i:       vc.Len(),
+		reverse: true,
+	}
+}
+
+func (vc *Vec[T]) iter() *vecIter[T] {
+	return &vecIter[T]{
+		vc: vc,
+		i:  -1,
+	}
+}
+
+// vecIter implements RandomAccessIterator over a Vec.
+type vecIter[T any] struct {
+	vc      *Vec[T]
+	i       int
+	reverse bool
+}
+
+func (it *vecIter[T]) valid() bool {
+	return it.i >= 0 && it.i < it.vc.Len()
+}
+
+// move moves the cursor `n` steps in the iterator's direction,
+// clamping the cursor to one position past the ends.
+func (it *vecIter[T]) move(n int) bool {
+	if it.reverse {
+		n = -n
+	}
+
+	it.i = Min(Max(it.i+n, -1), it.vc.Len())
+
+	return it.valid()
+}
 
-			return nil, cnt
-		},
-		advance: func(cnt, n int) (*T, int) {
-			cnt += n
-			if cnt < vc.Len() {
-				return &(*vc)[cnt], cnt
-			}
+func (it *vecIter[T]) Next() bool {
+	return it.move(1)
+}
+
+func (it *vecIter[T]) Prev() bool {
+	return it.move(-1)
+}
+
+func (it *vecIter[T]) Advance(n int) bool {
+	return it.move(n)
+}
+
+func (it *vecIter[T]) Seek(i int) bool {
+	it.i = Min(Max(i, -1), it.vc.Len())
+	return it.valid()
+}
+
+func (it *vecIter[T]) Index() int {
+	return it.i
+}
+
+func (it *vecIter[T]) Distance(other RandomAccessIterator[T]) int {
+	if it.reverse {
+		return it.i - other.Index()
+	}
+
+	return other.Index() - it.i
+}
+
+func (it *vecIter[T]) Get() T {
+	return (*it.vc)[it.i]
+}
 
-			return nil, cnt
-		},
+func (it *vecIter[T]) Ptr() *T {
+	if !it.valid() {
+		return nil
 	}
 
-	return it
+	return &(*it.vc)[it.i]
 }
 
 // Index returns the index of an element inside the vector.
@@ -0,0 +1,73 @@
+package gtl
+
+import (
+	"golang.org/x/exp/constraints"
+	"golang.org/x/exp/slices"
+)
+
+// SortFunc sorts the vector in ascending order as determined by `less`.
+func (vc Vec[T]) SortFunc(less func(a, b T) bool) {
+	slices.SortFunc(vc, less)
+}
+
+// SortStableFunc is like SortFunc but keeps the original order of equal elements.
+func (vc Vec[T]) SortStableFunc(less func(a, b T) bool) {
+	slices.SortStableFunc(vc, less)
+}
+
+// IsSortedFunc returns whether the vector is sorted in ascending order as determined by `less`.
+func (vc Vec[T]) IsSortedFunc(less func(a, b T) bool) bool {
+	return slices.IsSortedFunc(vc, less)
+}
+
+// BinarySearchFunc searches for `target` in a vector sorted as determined by `less`.
+//
+// Returns the position where `target` is found, or the position where `target` would be inserted
+// to keep the vector sorted, and whether `target` was found.
+func (vc Vec[T]) BinarySearchFunc(target T, less func(a, b T) bool) (int, bool) {
+	i := slices.BinarySearchFunc(vc, func(e T) bool {
+		return !less(e, target)
+	})
+
+	return i, i < len(vc) && !less(target, vc[i])
+}
+
+// InsertSortedFunc inserts `v` keeping the vector sorted as determined by `less`.
+//
+// `v` is inserted after the elements equal to it. Returns the position where `v` has been inserted.
+func (vc *Vec[T]) InsertSortedFunc(v T, less func(a, b T) bool) int {
+	i := slices.BinarySearchFunc(*vc, func(e T) bool {
+		return less(v, e)
+	})
+
+	vc.Insert(i, v)
+
+	return i
+}
+
+// Sort sorts the vector in ascending order.
+func Sort[T constraints.Ordered](vc Vec[T]) {
+	slices.Sort(vc)
+}
+
+// IsSorted returns whether the vector is sorted in ascending order.
+func IsSorted[T constraints.Ordered](vc Vec[T]) bool {
+	return slices.IsSorted(vc)
+}
+
+// BinarySearch searches for `target` in a sorted vector.
+//
+// Returns the position where `target` is found, or the position where `target` would be inserted
+// to keep the vector sorted, and whether `target` was found.
+func BinarySearch[T constraints.Ordered](vc Vec[T], target T) (int, bool) {
+	return vc.BinarySearchFunc(target, less[T])
+}
+
+// InsertSorted inserts `v` keeping the vector sorted. Returns the position where `v` has been inserted.
+func InsertSorted[T constraints.Ordered](vc *Vec[T], v T) int {
+	return vc.InsertSortedFunc(v, less[T])
+}
+
+func less[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
@@ -0,0 +1,259 @@
+package gtl
+
+import (
+	"testing"
+)
+
+func TestVecReverseIter(t *testing.T) {
+	vc := NewVec(1, 2, 3, 4, 5)
+
+	var values []int
+	for it := vc.ReverseIter(); it.Next(); {
+		values = append(values, it.Get())
+	}
+
+	expectVec(t, values, 5, 4, 3, 2, 1)
+
+	it := vc.ReverseIter()
+	if !it.Seek(2) || it.Get() != 3 {
+		t.Fatal("seek failed")
+	}
+
+	if !it.Next() || it.Get() != 2 || it.Index() != 1 {
+		t.Fatal("reverse next failed")
+	}
+
+	if !it.Prev() || it.Get() != 3 {
+		t.Fatal("reverse prev failed")
+	}
+
+	last := vc.ReverseIter()
+	last.Seek(0)
+
+	if d := it.Distance(last); d != 2 {
+		t.Fatalf("expected distance 2, got %d", d)
+	}
+
+	if it.Advance(3) || it.Ptr() != nil {
+		t.Fatal("advance should overflow")
+	}
+
+	if !it.Prev() || it.Get() != 1 {
+		t.Fatal("prev after overflow failed")
+	}
+}
+
+func TestVecIterAdvance(t *testing.T) {
+	vc := NewVec(1, 2, 3, 4, 5)
+
+	it := vc.Iter()
+	if !it.Advance(1) || it.Get() != 1 {
+		t.Fatal("advance from the start should point to the first element")
+	}
+
+	if !it.Advance(2) || it.Get() != 3 {
+		t.Fatalf("expected 3, got %d", it.Get())
+	}
+
+	if !it.Advance(-1) || it.Get() != 2 {
+		t.Fatalf("expected 2, got %d", it.Get())
+	}
+
+	if e, ok := vc.Del(it); !ok || e != 2 {
+		t.Fatal("delete failed")
+	}
+
+	expectVec(t, vc, 1, 3, 4, 5)
+}
+
+func TestVecFilter(t *testing.T) {
+	vc := NewVec(1, 2, 2, 3, 4)
+
+	vc.Filter(func(it Iterator[int]) bool {
+		return it.Get()%2 != 0
+	})
+
+	expectVec(t, vc, 1, 3)
+}
+
+func TestVecEdit(t *testing.T) {
+	vc := NewVec(1, 2, 3)
+
+	if err := vc.Insert(1, 10, 11); err != nil {
+		t.Fatal(err)
+	}
+
+	expectVec(t, vc, 1, 10, 11, 2, 3)
+
+	if err := vc.InsertVec(5, NewVec(4)); err != nil {
+		t.Fatal(err)
+	}
+
+	expectVec(t, vc, 1, 10, 11, 2, 3, 4)
+
+	if err := vc.Insert(7, 0); err != ErrOutOfRange {
+		t.Fatalf("expected ErrOutOfRange, got %v", err)
+	}
+
+	if err := vc.Splice(1, 3, 20); err != nil {
+		t.Fatal(err)
+	}
+
+	expectVec(t, vc, 1, 20, 2, 3, 4)
+
+	if err := vc.Splice(3, 2); err != ErrOutOfRange {
+		t.Fatalf("expected ErrOutOfRange, got %v", err)
+	}
+
+	if e := vc.SwapRemove(1); e.Get() != 20 {
+		t.Fatalf("expected 20, got %d", e.Get())
+	}
+
+	expectVec(t, vc, 1, 4, 2, 3)
+
+	if vc.SwapRemove(4).HasValue() {
+		t.Fatal("swap remove out of range")
+	}
+
+	drained := vc.Drain(1, 3)
+	if !drained.IsOk() {
+		t.Fatal(drained.Error())
+	}
+
+	expectVec(t, drained.Get(), 4, 2)
+	expectVec(t, vc, 1, 3)
+
+	if vc.Drain(1, 3).Error() != ErrOutOfRange {
+		t.Fatal("expected ErrOutOfRange")
+	}
+
+	vc.Append(5, 6, 7)
+	vc.Retain(func(n int) bool {
+		return n%2 != 0
+	})
+
+	expectVec(t, vc, 1, 3, 5, 7)
+
+	vc.Truncate(2)
+	expectVec(t, vc, 1, 3)
+
+	vc.Truncate(10)
+	expectVec(t, vc, 1, 3)
+
+	vc.Clear()
+	if vc.Len() != 0 || vc.Cap() == 0 {
+		t.Fatal("clear failed")
+	}
+}
+
+func TestVecSort(t *testing.T) {
+	vc := NewVec(5, 3, 1, 4, 2)
+
+	Sort(vc)
+	expectVec(t, vc, 1, 2, 3, 4, 5)
+
+	if !IsSorted(vc) {
+		t.Fatal("vector should be sorted")
+	}
+
+	if i, ok := BinarySearch(vc, 4); !ok || i != 3 {
+		t.Fatalf("expected 4 at 3, got %d %v", i, ok)
+	}
+
+	vc.Splice(2, 3)
+	if i, ok := BinarySearch(vc, 3); ok || i != 2 {
+		t.Fatalf("expected insertion point 2, got %d %v", i, ok)
+	}
+
+	if i := InsertSorted(&vc, 3); i != 2 {
+		t.Fatalf("expected 3 to be inserted at 2, got %d", i)
+	}
+
+	expectVec(t, vc, 1, 2, 3, 4, 5)
+
+	vc.SortFunc(func(a, b int) bool {
+		return a > b
+	})
+	expectVec(t, vc, 5, 4, 3, 2, 1)
+}
+
+func TestSortedVec(t *testing.T) {
+	type trade struct {
+		time  int
+		price float64
+	}
+
+	sv := NewSortedVecFunc(func(a, b trade) bool {
+		return a.time < b.time
+	}, trade{3, 30}, trade{1, 10})
+
+	sv.Insert(trade{2, 20}, trade{5, 50}, trade{2, 21})
+
+	// last trade before or at time 2
+	i := sv.UpperBound(trade{time: 2}) - 1
+	if tr := sv.Get(i); tr.price != 21 {
+		t.Fatalf("expected the last trade at time 2, got %v", tr)
+	}
+
+	if sv.LowerBound(trade{time: 4}) != 4 {
+		t.Fatal("unexpected lower bound")
+	}
+
+	if !sv.Remove(trade{time: 3}) || sv.Contains(trade{time: 3}) {
+		t.Fatal("remove failed")
+	}
+
+	if sv.Front().time != 1 || sv.Back().time != 5 || sv.Len() != 4 {
+		t.Fatal("unexpected order")
+	}
+}
+
+func TestVecViews(t *testing.T) {
+	vc := NewVec(1, 2, 3, 4, 5)
+
+	chunks := Collect(vc.Chunks(2))
+	if chunks.Len() != 3 {
+		t.Fatalf("expected 3 chunks, got %d", chunks.Len())
+	}
+
+	expectVec(t, chunks[2], 5)
+
+	chunks[0][0] = 10
+	if vc[0] != 10 {
+		t.Fatal("chunks should share the backing array")
+	}
+
+	chunks[0].Append(20)
+	if vc[2] != 3 {
+		t.Fatal("appending to a chunk overwrote the vector")
+	}
+
+	windows := Collect(vc.Windows(3))
+	if windows.Len() != 3 {
+		t.Fatalf("expected 3 windows, got %d", windows.Len())
+	}
+
+	expectVec(t, windows[1], 2, 3, 4)
+
+	if Count(vc.Windows(6)) != 0 {
+		t.Fatal("expected no windows")
+	}
+
+	parts := Collect(NewVec(1, 0, 2, 3, 0, 0).SplitBy(func(n int) bool {
+		return n == 0
+	}))
+	if parts.Len() != 4 {
+		t.Fatalf("expected 4 parts, got %d", parts.Len())
+	}
+
+	expectVec(t, parts[1], 2, 3)
+
+	if parts[2].Len() != 0 || parts[3].Len() != 0 {
+		t.Fatal("expected empty parts")
+	}
+
+	firsts := Map(Dedup(NewVec(1, 1, 2, 3, 3, 3, 1)), func(run Vec[int]) int {
+		return run.Front()
+	})
+	expectVec(t, Collect(firsts), 1, 2, 3, 1)
+}
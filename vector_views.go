@@ -0,0 +1,95 @@
+package gtl
+
+// Chunks returns an Iterator over non-overlapping sub-vectors of `n` elements.
+//
+// The sub-vectors share the backing array with `vc`. The last sub-vector might hold less than `n` elements.
+func (vc Vec[T]) Chunks(n int) Iterator[Vec[T]] {
+	i := 0
+
+	return iterFrom(func() *Vec[T] {
+		if n <= 0 || i >= len(vc) {
+			return nil
+		}
+
+		j := Min(i+n, len(vc))
+		chunk := vc[i:j:j]
+		i = j
+
+		return &chunk
+	})
+}
+
+// Windows returns an Iterator over all the overlapping sub-vectors of `n` elements.
+//
+// The sub-vectors share the backing array with `vc`. If `vc` has less than `n` elements, no window is returned.
+func (vc Vec[T]) Windows(n int) Iterator[Vec[T]] {
+	i := 0
+
+	return iterFrom(func() *Vec[T] {
+		if n <= 0 || i+n > len(vc) {
+			return nil
+		}
+
+		window := vc[i : i+n : i+n]
+		i++
+
+		return &window
+	})
+}
+
+// SplitBy returns an Iterator over the sub-vectors separated by the elements for which `fn` returns true.
+//
+// The separators are not included in the sub-vectors. The sub-vectors share the backing array with `vc`.
+func (vc Vec[T]) SplitBy(fn func(T) bool) Iterator[Vec[T]] {
+	i, done := 0, false
+
+	return iterFrom(func() *Vec[T] {
+		if done {
+			return nil
+		}
+
+		j := i
+		for j < len(vc) && !fn(vc[j]) {
+			j++
+		}
+
+		part := vc[i:j:j]
+		if j == len(vc) {
+			done = true
+		}
+
+		i = j + 1
+
+		return &part
+	})
+}
+
+// DedupFunc returns an Iterator over the runs of consecutive elements for which `eq` returns true.
+//
+// The first element of every run is the deduplicated value. The runs share the backing array with `vc`.
+func (vc Vec[T]) DedupFunc(eq func(a, b T) bool) Iterator[Vec[T]] {
+	i := 0
+
+	return iterFrom(func() *Vec[T] {
+		if i >= len(vc) {
+			return nil
+		}
+
+		j := i + 1
+		for j < len(vc) && eq(vc[i], vc[j]) {
+			j++
+		}
+
+		run := vc[i:j:j]
+		i = j
+
+		return &run
+	})
+}
+
+// Dedup returns an Iterator over the runs of consecutive equal elements.
+func Dedup[T comparable](vc Vec[T]) Iterator[Vec[T]] {
+	return vc.DedupFunc(func(a, b T) bool {
+		return a == b
+	})
+}
@@ -0,0 +1,149 @@
+package gtl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrPoolClosed is returned when submitting a job to a WorkerPool that has been shut down.
+var ErrPoolClosed = errors.New("gtl: worker pool closed")
+
+// WorkerPoolStats holds the counters of a WorkerPool.
+type WorkerPoolStats struct {
+	// Queued is the number of jobs waiting for a worker.
+	Queued int64
+	// Running is the number of jobs being executed.
+	Running int64
+	// Completed is the number of jobs finished, either successfully or not.
+	Completed int64
+}
+
+type poolJob[In, Out any] struct {
+	ctx    context.Context
+	in     In
+	future *Future[Out]
+}
+
+// WorkerPool executes jobs using a fixed number of workers and a bounded queue.
+type WorkerPool[In, Out any] struct {
+	fn     func(context.Context, In) (Out, error)
+	ch     Channel[poolJob[In, Out]]
+	sender Sender[poolJob[In, Out]]
+	wg     sync.WaitGroup
+
+	queued    atomic.Int64
+	running   atomic.Int64
+	completed atomic.Int64
+}
+
+// NewWorkerPool returns a WorkerPool executing `fn` from `workers` goroutines.
+//
+// Up to `queueSize` jobs can wait for a worker before Submit blocks.
+// NewWorkerPool panics if `workers` <= 0 or `queueSize` < 0.
+func NewWorkerPool[In, Out any](workers, queueSize int, fn func(context.Context, In) (Out, error)) *WorkerPool[In, Out] {
+	if workers <= 0 {
+		panic(fmt.Sprintf("gtl: invalid number of workers %d", workers))
+	}
+
+	if queueSize < 0 {
+		panic(fmt.Sprintf("gtl: invalid queue size %d", queueSize))
+	}
+
+	p := &WorkerPool[In, Out]{
+		fn: fn,
+		ch: MakeChan[poolJob[In, Out]](queueSize),
+	}
+
+	sender, recv := p.ch.Split()
+	p.sender = sender
+
+	p.wg.Add(workers)
+
+	for i := 0; i < workers; i++ {
+		go p.work(recv)
+	}
+
+	return p
+}
+
+// Submit queues `in` to be processed, blocking if the queue is full.
+//
+// The returned Future resolves to the Result of the job. If `ctx` is done before the job is queued
+// or executed, or the pool has been shut down, the Future resolves to the error.
+// A panic in the job is recovered and reported as a *PanicError.
+func (p *WorkerPool[In, Out]) Submit(ctx context.Context, in In) *Future[Out] {
+	job := poolJob[In, Out]{
+		ctx:    ctx,
+		in:     in,
+		future: newFuture[Out](),
+	}
+
+	p.queued.Add(1)
+
+	if err := p.sender.SendCtx(ctx, job); err != nil {
+		p.queued.Add(-1)
+
+		if err == ErrClosed {
+			err = ErrPoolClosed
+		}
+
+		var r Result[Out]
+		job.future.complete(r.Err(err))
+	}
+
+	return job.future
+}
+
+// Shutdown stops accepting jobs and waits until the queued and running jobs are finished,
+// or until `ctx` is done, in which case the context's error is returned.
+func (p *WorkerPool[In, Out]) Shutdown(ctx context.Context) error {
+	p.ch.Close()
+
+	done := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stats returns the counters of the pool.
+func (p *WorkerPool[In, Out]) Stats() WorkerPoolStats {
+	return WorkerPoolStats{
+		Queued:    p.queued.Load(),
+		Running:   p.running.Load(),
+		Completed: p.completed.Load(),
+	}
+}
+
+func (p *WorkerPool[In, Out]) work(recv Receiver[poolJob[In, Out]]) {
+	defer p.wg.Done()
+
+	recv.Range(func(job poolJob[In, Out]) {
+		p.queued.Add(-1)
+		p.running.Add(1)
+
+		var r Result[Out]
+		if err := job.ctx.Err(); err != nil {
+			r = r.Err(err)
+		} else {
+			r = Try(func() (Out, error) {
+				return p.fn(job.ctx, job.in)
+			})
+		}
+
+		job.future.complete(r)
+
+		p.running.Add(-1)
+		p.completed.Add(1)
+	})
+}
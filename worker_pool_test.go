@@ -0,0 +1,101 @@
+package gtl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWorkerPool(t *testing.T) {
+	errNeg := errors.New("negative")
+
+	pool := NewWorkerPool(4, 8, func(ctx context.Context, n int) (int, error) {
+		switch {
+		case n < 0:
+			return 0, errNeg
+		case n == 13:
+			panic("unlucky")
+		}
+
+		return n * 2, nil
+	})
+
+	ctx := context.Background()
+
+	futures := make([]*Future[int], 20)
+	for i := range futures {
+		futures[i] = pool.Submit(ctx, i)
+	}
+
+	for i, f := range futures {
+		r := f.Await(ctx)
+		if i == 13 {
+			var perr *PanicError
+			if !r.AsErr(&perr) {
+				t.Fatalf("expected a panic error, got %v", r.Error())
+			}
+
+			continue
+		}
+
+		if r.Get() != i*2 {
+			t.Fatalf("expected %d, got %v %v", i*2, r.Get(), r.Error())
+		}
+	}
+
+	if r := pool.Submit(ctx, -1).Await(ctx); r.Error() != errNeg {
+		t.Fatalf("expected errNeg, got %v", r.Error())
+	}
+
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if r := pool.Submit(ctx, 1).Await(ctx); r.Error() != ErrPoolClosed {
+		t.Fatalf("expected ErrPoolClosed, got %v", r.Error())
+	}
+
+	stats := pool.Stats()
+	if stats.Completed != 21 || stats.Queued != 0 || stats.Running != 0 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+}
+
+func TestWorkerPoolShutdown(t *testing.T) {
+	release := make(chan struct{})
+
+	pool := NewWorkerPool(1, 1, func(ctx context.Context, n int) (int, error) {
+		<-release
+		return n, nil
+	})
+
+	ctx := context.Background()
+
+	running := pool.Submit(ctx, 1)
+	queued := pool.Submit(ctx, 2)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if r := pool.Submit(canceled, 3).Await(ctx); r.Error() != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", r.Error())
+	}
+
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+
+	if err := pool.Shutdown(timeout); err != context.DeadlineExceeded {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	close(release)
+
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if running.Poll().Get().Get() != 1 || queued.Poll().Get().Get() != 2 {
+		t.Fatal("the in-flight jobs were not drained")
+	}
+}